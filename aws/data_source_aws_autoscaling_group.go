@@ -0,0 +1,284 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceAwsAutoscalingGroup() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsAutoscalingGroupRead,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"launch_configuration": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"launch_template": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     dataSourceAwsAutoscalingGroupLaunchTemplateElem(),
+			},
+			"min_size": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"max_size": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"desired_capacity": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"default_cooldown": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"health_check_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"health_check_grace_period": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"load_balancers": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"target_group_arns": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"availability_zones": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"vpc_zone_identifier": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"suspended_processes": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"enabled_metrics": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"termination_policies": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"placement_group": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"protect_from_scale_in": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tag": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"value": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"propagate_at_launch": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+				Set: autoscalingTagToHash,
+			},
+			"instances": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"availability_zone": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"health_status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"lifecycle_state": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"launch_configuration": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"launch_template": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     dataSourceAwsAutoscalingGroupLaunchTemplateElem(),
+						},
+						"protected_from_scale_in": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAwsAutoscalingGroupLaunchTemplateElem() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceAwsAutoscalingGroupRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).autoscalingconn
+	name := d.Get("name").(string)
+
+	log.Printf("[DEBUG] Reading Autoscaling Group: %s", name)
+	g, err := getAwsAutoscalingGroup(name, conn)
+	if err != nil {
+		return err
+	}
+	if g == nil {
+		return fmt.Errorf("no Autoscaling Group found with name %q", name)
+	}
+
+	d.SetId(aws.StringValue(g.AutoScalingGroupName))
+	d.Set("arn", g.AutoScalingGroupARN)
+	d.Set("launch_configuration", g.LaunchConfigurationName)
+	d.Set("min_size", g.MinSize)
+	d.Set("max_size", g.MaxSize)
+	d.Set("desired_capacity", g.DesiredCapacity)
+	d.Set("default_cooldown", g.DefaultCooldown)
+	d.Set("health_check_type", g.HealthCheckType)
+	d.Set("health_check_grace_period", g.HealthCheckGracePeriod)
+	d.Set("placement_group", g.PlacementGroup)
+	d.Set("protect_from_scale_in", g.NewInstancesProtectedFromScaleIn)
+	d.Set("status", g.Status)
+
+	if err := d.Set("launch_template", flattenAsgLaunchTemplateSpecification(g.LaunchTemplate)); err != nil {
+		return fmt.Errorf("Error setting launch_template: %s", err)
+	}
+	if err := d.Set("load_balancers", flattenStringList(g.LoadBalancerNames)); err != nil {
+		return fmt.Errorf("Error setting load_balancers: %s", err)
+	}
+	if err := d.Set("target_group_arns", flattenStringList(g.TargetGroupARNs)); err != nil {
+		return fmt.Errorf("Error setting target_group_arns: %s", err)
+	}
+	if err := d.Set("availability_zones", flattenStringList(g.AvailabilityZones)); err != nil {
+		return fmt.Errorf("Error setting availability_zones: %s", err)
+	}
+
+	var subnets []string
+	if v := aws.StringValue(g.VPCZoneIdentifier); v != "" {
+		subnets = strings.Split(v, ",")
+	}
+	if err := d.Set("vpc_zone_identifier", subnets); err != nil {
+		return fmt.Errorf("Error setting vpc_zone_identifier: %s", err)
+	}
+
+	if err := d.Set("suspended_processes", flattenAsgSuspendedProcesses(g.SuspendedProcesses)); err != nil {
+		return fmt.Errorf("Error setting suspended_processes: %s", err)
+	}
+	if err := d.Set("enabled_metrics", flattenAsgEnabledMetrics(g.EnabledMetrics)); err != nil {
+		return fmt.Errorf("Error setting enabled_metrics: %s", err)
+	}
+	if err := d.Set("termination_policies", flattenStringList(g.TerminationPolicies)); err != nil {
+		return fmt.Errorf("Error setting termination_policies: %s", err)
+	}
+	if err := d.Set("tag", autoscalingTagDescriptionsToSlice(g.Tags)); err != nil {
+		return fmt.Errorf("Error setting tag: %s", err)
+	}
+
+	instances := make([]map[string]interface{}, 0, len(g.Instances))
+	for _, i := range g.Instances {
+		instances = append(instances, map[string]interface{}{
+			"id":                      aws.StringValue(i.InstanceId),
+			"availability_zone":       aws.StringValue(i.AvailabilityZone),
+			"health_status":           aws.StringValue(i.HealthStatus),
+			"lifecycle_state":         aws.StringValue(i.LifecycleState),
+			"launch_configuration":    aws.StringValue(i.LaunchConfigurationName),
+			"launch_template":         flattenAsgLaunchTemplateSpecification(i.LaunchTemplate),
+			"protected_from_scale_in": aws.BoolValue(i.ProtectedFromScaleIn),
+		})
+	}
+	if err := d.Set("instances", instances); err != nil {
+		return fmt.Errorf("Error setting instances: %s", err)
+	}
+
+	return nil
+}
+
+func flattenAsgLaunchTemplateSpecification(lt *autoscaling.LaunchTemplateSpecification) []interface{} {
+	if lt == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"id":      aws.StringValue(lt.LaunchTemplateId),
+			"name":    aws.StringValue(lt.LaunchTemplateName),
+			"version": aws.StringValue(lt.Version),
+		},
+	}
+}
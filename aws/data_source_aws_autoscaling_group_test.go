@@ -0,0 +1,110 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAWSAutoscalingGroupDataSource_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_autoscaling_group.test"
+	datasourceName := "data.aws_autoscaling_group.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSAutoscalingGroupDataSourceConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(datasourceName, "name", resourceName, "name"),
+					resource.TestCheckResourceAttrPair(datasourceName, "arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(datasourceName, "launch_configuration", resourceName, "launch_configuration"),
+					resource.TestCheckResourceAttr(datasourceName, "min_size", "1"),
+					resource.TestCheckResourceAttr(datasourceName, "max_size", "2"),
+					resource.TestCheckResourceAttr(datasourceName, "desired_capacity", "1"),
+					resource.TestCheckResourceAttr(datasourceName, "health_check_type", "EC2"),
+					resource.TestCheckResourceAttr(datasourceName, "availability_zones.#", "1"),
+					resource.TestCheckResourceAttr(datasourceName, "suspended_processes.#", "1"),
+					resource.TestCheckResourceAttr(datasourceName, "launch_template.#", "0"),
+					resource.TestCheckResourceAttr(datasourceName, "tag.#", "1"),
+					resource.TestCheckResourceAttr(datasourceName, "instances.#", "1"),
+					resource.TestMatchResourceAttr(datasourceName, "instances.0.id", regexp.MustCompile("^i-")),
+					resource.TestCheckResourceAttr(datasourceName, "instances.0.lifecycle_state", "InService"),
+					resource.TestCheckResourceAttr(datasourceName, "instances.0.health_status", "Healthy"),
+					resource.TestCheckResourceAttrPair(datasourceName, "instances.0.launch_configuration", resourceName, "launch_configuration"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSAutoscalingGroupDataSource_notFound(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAWSAutoscalingGroupDataSourceConfig_notFound,
+				ExpectError: regexp.MustCompile(`no Autoscaling Group found`),
+			},
+		},
+	})
+}
+
+func testAccAWSAutoscalingGroupDataSourceConfig(rName string) string {
+	return fmt.Sprintf(`
+data "aws_ami" "test_ami" {
+  most_recent = true
+
+  filter {
+    name   = "owner-alias"
+    values = ["amazon"]
+  }
+
+  filter {
+    name   = "name"
+    values = ["amzn-ami-hvm-*-x86_64-gp2"]
+  }
+}
+
+data "aws_availability_zones" "available" {}
+
+resource "aws_launch_configuration" "test" {
+  name_prefix   = "%[1]s-"
+  image_id      = "${data.aws_ami.test_ami.id}"
+  instance_type = "t2.micro"
+}
+
+resource "aws_autoscaling_group" "test" {
+  name                 = "%[1]s"
+  availability_zones   = ["${data.aws_availability_zones.available.names[0]}"]
+  min_size             = 1
+  max_size             = 2
+  desired_capacity     = 1
+  health_check_type    = "EC2"
+  launch_configuration = "${aws_launch_configuration.test.name}"
+  suspended_processes  = ["AZRebalance"]
+
+  tag {
+    key                 = "Name"
+    value               = "%[1]s"
+    propagate_at_launch = true
+  }
+}
+
+data "aws_autoscaling_group" "test" {
+  name = "${aws_autoscaling_group.test.name}"
+}
+`, rName)
+}
+
+const testAccAWSAutoscalingGroupDataSourceConfig_notFound = `
+data "aws_autoscaling_group" "test" {
+  name = "tf-acc-test-does-not-exist"
+}
+`
@@ -145,6 +145,18 @@ func dataSourceAwsDynamoDbTable() *schema.Resource {
 					return strings.ToUpper(value)
 				},
 			},
+			"server_side_encryption": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"tags": tagsSchemaComputed(),
 			"ttl": {
 				Type:     schema.TypeSet,
@@ -0,0 +1,95 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceAwsDynamoDbTableBackup() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsDynamoDbTableBackupRead,
+
+		Schema: map[string]*schema.Schema{
+			"table_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"table_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"size_bytes": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"creation_date_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceAwsDynamoDbTableBackupRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).dynamodbconn
+
+	tableName := d.Get("table_name").(string)
+
+	input := &dynamodb.ListBackupsInput{
+		TableName: aws.String(tableName),
+	}
+
+	var latest *dynamodb.BackupSummary
+	for {
+		log.Printf("[DEBUG] Listing DynamoDB table backups: %s", input)
+		output, err := conn.ListBackups(input)
+		if err != nil {
+			return fmt.Errorf("error listing DynamoDB table backups: %s", err)
+		}
+
+		for _, backup := range output.BackupSummaries {
+			if aws.StringValue(backup.BackupStatus) != dynamodb.BackupStatusAvailable {
+				continue
+			}
+			if latest == nil || backup.BackupCreationDateTime.After(*latest.BackupCreationDateTime) {
+				latest = backup
+			}
+		}
+
+		if output.LastEvaluatedBackupArn == nil {
+			break
+		}
+		input.ExclusiveStartBackupArn = output.LastEvaluatedBackupArn
+	}
+
+	if latest == nil {
+		return fmt.Errorf("Your query returned no results. Please change your search criteria and try again.")
+	}
+
+	d.SetId(aws.StringValue(latest.BackupArn))
+	d.Set("arn", latest.BackupArn)
+	d.Set("name", latest.BackupName)
+	d.Set("table_arn", latest.TableArn)
+	d.Set("size_bytes", latest.BackupSizeBytes)
+	d.Set("status", latest.BackupStatus)
+	d.Set("creation_date_time", latest.BackupCreationDateTime.Format(time.RFC3339))
+
+	return nil
+}
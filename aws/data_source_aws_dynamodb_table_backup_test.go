@@ -0,0 +1,48 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceAwsDynamoDbTableBackup_basic(t *testing.T) {
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(8))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAwsDynamoDbTableBackupConfig(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.aws_dynamodb_table_backup.test", "arn", "aws_dynamodb_table_backup.second", "arn"),
+					resource.TestCheckResourceAttr("data.aws_dynamodb_table_backup.test", "name", rName+"-second"),
+					resource.TestCheckResourceAttr("data.aws_dynamodb_table_backup.test", "status", dynamodb.BackupStatusAvailable),
+					resource.TestCheckResourceAttrPair("data.aws_dynamodb_table_backup.test", "table_arn", "aws_dynamodb_table.test", "arn"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAwsDynamoDbTableBackupConfig(rName string) string {
+	return testAccDynamoDbTableBackupConfigTable(rName) + fmt.Sprintf(`
+resource "aws_dynamodb_table_backup" "first" {
+  name       = "%[1]s-first"
+  table_name = "${aws_dynamodb_table.test.name}"
+}
+
+resource "aws_dynamodb_table_backup" "second" {
+  name       = "%[1]s-second"
+  table_name = "${aws_dynamodb_table_backup.first.table_name}"
+}
+
+data "aws_dynamodb_table_backup" "test" {
+  table_name = "${aws_dynamodb_table_backup.second.table_name}"
+}
+`, rName)
+}
@@ -0,0 +1,220 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"sort"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceAwsEc2ReservedInstancesOfferings() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsEc2ReservedInstancesOfferingsRead,
+
+		Schema: map[string]*schema.Schema{
+			"filter": ec2CustomFiltersSchema(),
+
+			"instance_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"availability_zone": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"instance_tenancy": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"offering_class": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"offering_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"product_description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"min_duration": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+
+			"max_duration": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+
+			"include_marketplace": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"offerings": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"instance_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"availability_zone": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"scope": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"instance_tenancy": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"offering_class": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"offering_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"product_description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"duration": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"fixed_price": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+						"usage_price": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+						"currency_code": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"marketplace": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAwsEc2ReservedInstancesOfferingsRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	req := &ec2.DescribeReservedInstancesOfferingsInput{
+		IncludeMarketplace: aws.Bool(d.Get("include_marketplace").(bool)),
+	}
+
+	if v, ok := d.GetOk("instance_type"); ok {
+		req.InstanceType = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("availability_zone"); ok {
+		req.AvailabilityZone = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("instance_tenancy"); ok {
+		req.InstanceTenancy = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("offering_class"); ok {
+		req.OfferingClass = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("offering_type"); ok {
+		req.OfferingType = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("product_description"); ok {
+		req.ProductDescription = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("min_duration"); ok {
+		req.MinDuration = aws.Int64(int64(v.(int)))
+	}
+	if v, ok := d.GetOk("max_duration"); ok {
+		req.MaxDuration = aws.Int64(int64(v.(int)))
+	}
+
+	req.Filters = buildEC2CustomFilterList(d.Get("filter").(*schema.Set))
+	if len(req.Filters) == 0 {
+		// Don't send an empty filters list; the EC2 API won't accept it.
+		req.Filters = nil
+	}
+
+	offerings, err := describeEc2ReservedInstancesOfferings(conn, req)
+	if err != nil {
+		return err
+	}
+
+	if len(offerings) == 0 {
+		return fmt.Errorf("no matching EC2 Reserved Instances offering found")
+	}
+
+	sort.Slice(offerings, func(i, j int) bool {
+		return aws.StringValue(offerings[i].ReservedInstancesOfferingId) < aws.StringValue(offerings[j].ReservedInstancesOfferingId)
+	})
+
+	ids := make([]string, 0, len(offerings))
+	l := make([]map[string]interface{}, 0, len(offerings))
+	for _, o := range offerings {
+		ids = append(ids, aws.StringValue(o.ReservedInstancesOfferingId))
+		l = append(l, map[string]interface{}{
+			"id":                  aws.StringValue(o.ReservedInstancesOfferingId),
+			"instance_type":       aws.StringValue(o.InstanceType),
+			"availability_zone":   aws.StringValue(o.AvailabilityZone),
+			"scope":               aws.StringValue(o.Scope),
+			"instance_tenancy":    aws.StringValue(o.InstanceTenancy),
+			"offering_class":      aws.StringValue(o.OfferingClass),
+			"offering_type":       aws.StringValue(o.OfferingType),
+			"product_description": aws.StringValue(o.ProductDescription),
+			"duration":            int(aws.Int64Value(o.Duration)),
+			"fixed_price":         aws.Float64Value(o.FixedPrice),
+			"usage_price":         aws.Float64Value(o.UsagePrice),
+			"currency_code":       aws.StringValue(o.CurrencyCode),
+			"marketplace":         aws.BoolValue(o.Marketplace),
+		})
+	}
+
+	log.Printf("[DEBUG] Found %d EC2 Reserved Instances offerings via given filter", len(ids))
+
+	d.SetId(resource.UniqueId())
+	if err := d.Set("ids", ids); err != nil {
+		return fmt.Errorf("Error setting offering ids: %s", err)
+	}
+	if err := d.Set("offerings", l); err != nil {
+		return fmt.Errorf("Error setting offerings: %s", err)
+	}
+
+	return nil
+}
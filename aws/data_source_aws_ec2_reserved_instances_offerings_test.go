@@ -0,0 +1,44 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceAwsEc2ReservedInstancesOfferings_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAwsEc2ReservedInstancesOfferingsConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.aws_ec2_reserved_instances_offerings.test", "ids.#", "1"),
+					resource.TestCheckResourceAttr("data.aws_ec2_reserved_instances_offerings.test", "offerings.#", "1"),
+					resource.TestCheckResourceAttr("data.aws_ec2_reserved_instances_offerings.test", "offerings.0.instance_type", "t2.nano"),
+					resource.TestCheckResourceAttr("data.aws_ec2_reserved_instances_offerings.test", "offerings.0.duration", "31536000"),
+					resource.TestCheckResourceAttr("data.aws_ec2_reserved_instances_offerings.test", "offerings.0.scope", "Region"),
+					resource.TestCheckResourceAttr("data.aws_ec2_reserved_instances_offerings.test", "offerings.0.marketplace", "false"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDataSourceAwsEc2ReservedInstancesOfferingsConfig = `
+data "aws_ec2_reserved_instances_offerings" "test" {
+  instance_type       = "t2.nano"
+  instance_tenancy    = "default"
+  offering_class      = "standard"
+  offering_type       = "No Upfront"
+  product_description = "Linux/UNIX"
+  min_duration        = 31536000
+  max_duration        = 31536000
+
+  filter {
+    name   = "scope"
+    values = ["Region"]
+  }
+}
+`
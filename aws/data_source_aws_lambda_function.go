@@ -0,0 +1,245 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceAwsLambdaFunction() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsLambdaFunctionRead,
+
+		Schema: map[string]*schema.Schema{
+			"function_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			// A version number or alias name.
+			"qualifier": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"qualified_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"invoke_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"role": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"runtime": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"handler": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"memory_size": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"timeout": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"reserved_concurrent_executions": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"last_modified": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"source_code_hash": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"source_code_size": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"kms_key_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"environment": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"variables": {
+							Type:     schema.TypeMap,
+							Computed: true,
+							Elem:     schema.TypeString,
+						},
+					},
+				},
+			},
+			"vpc_config": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"subnet_ids": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+							Set:      schema.HashString,
+						},
+						"security_group_ids": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+							Set:      schema.HashString,
+						},
+						"vpc_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"dead_letter_config": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"target_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"tracing_config": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"mode": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"tags": tagsSchemaComputed(),
+		},
+	}
+}
+
+func dataSourceAwsLambdaFunctionRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lambdaconn
+
+	functionName := d.Get("function_name").(string)
+	params := &lambda.GetFunctionInput{
+		FunctionName: aws.String(functionName),
+	}
+
+	qualifier := "$LATEST"
+	if v, ok := d.GetOk("qualifier"); ok {
+		qualifier = v.(string)
+		params.Qualifier = aws.String(qualifier)
+	}
+
+	log.Printf("[DEBUG] Fetching Lambda Function: %s", params)
+	out, err := conn.GetFunction(params)
+	if err != nil {
+		if isAWSErr(err, lambda.ErrCodeResourceNotFoundException, "") {
+			return fmt.Errorf("Lambda Function %q (qualifier %q) not found", functionName, qualifier)
+		}
+		return fmt.Errorf("Error fetching Lambda Function %q: %s", functionName, err)
+	}
+
+	function := out.Configuration
+
+	// The ARN returned by GetFunction includes the qualifier if one was given.
+	arn := aws.StringValue(function.FunctionArn)
+	if params.Qualifier != nil {
+		arn = strings.TrimSuffix(arn, ":"+qualifier)
+	}
+	qualifiedArn := arn + ":" + qualifier
+
+	d.SetId(qualifiedArn)
+	d.Set("arn", arn)
+	d.Set("qualified_arn", qualifiedArn)
+	d.Set("version", function.Version)
+	d.Set("description", function.Description)
+	d.Set("role", function.Role)
+	d.Set("runtime", function.Runtime)
+	d.Set("handler", function.Handler)
+	d.Set("memory_size", function.MemorySize)
+	d.Set("timeout", function.Timeout)
+	d.Set("last_modified", function.LastModified)
+	d.Set("source_code_hash", function.CodeSha256)
+	d.Set("source_code_size", function.CodeSize)
+	d.Set("kms_key_arn", function.KMSKeyArn)
+
+	// Invoking via an alias or version has to go through its qualified ARN.
+	if params.Qualifier != nil {
+		d.Set("invoke_arn", buildLambdaInvokeArn(qualifiedArn, meta.(*AWSClient).region))
+	} else {
+		d.Set("invoke_arn", buildLambdaInvokeArn(arn, meta.(*AWSClient).region))
+	}
+
+	if out.Concurrency != nil {
+		d.Set("reserved_concurrent_executions", out.Concurrency.ReservedConcurrentExecutions)
+	} else {
+		d.Set("reserved_concurrent_executions", nil)
+	}
+
+	if err := d.Set("environment", flattenLambdaEnvironment(function.Environment)); err != nil {
+		return fmt.Errorf("Error setting environment: %s", err)
+	}
+	if err := d.Set("vpc_config", flattenLambdaVpcConfigResponse(function.VpcConfig)); err != nil {
+		return fmt.Errorf("Error setting vpc_config: %s", err)
+	}
+
+	deadLetterConfig := []interface{}{}
+	if function.DeadLetterConfig != nil && function.DeadLetterConfig.TargetArn != nil {
+		deadLetterConfig = append(deadLetterConfig, map[string]interface{}{
+			"target_arn": aws.StringValue(function.DeadLetterConfig.TargetArn),
+		})
+	}
+	if err := d.Set("dead_letter_config", deadLetterConfig); err != nil {
+		return fmt.Errorf("Error setting dead_letter_config: %s", err)
+	}
+
+	tracingConfig := []interface{}{}
+	if function.TracingConfig != nil {
+		tracingConfig = append(tracingConfig, map[string]interface{}{
+			"mode": aws.StringValue(function.TracingConfig.Mode),
+		})
+	}
+	if err := d.Set("tracing_config", tracingConfig); err != nil {
+		return fmt.Errorf("Error setting tracing_config: %s", err)
+	}
+
+	if err := d.Set("tags", tagsToMapGeneric(out.Tags)); err != nil {
+		return fmt.Errorf("Error setting tags: %s", err)
+	}
+
+	return nil
+}
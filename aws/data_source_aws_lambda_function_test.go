@@ -0,0 +1,128 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceAWSLambdaFunction_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_lambda_function.test"
+	dataSourceName := "data.aws_lambda_function.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAWSLambdaFunctionConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "invoke_arn", resourceName, "invoke_arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "role", resourceName, "role"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "source_code_hash", resourceName, "source_code_hash"),
+					resource.TestCheckResourceAttr(dataSourceName, "runtime", "nodejs6.10"),
+					resource.TestCheckResourceAttr(dataSourceName, "handler", "lambda_invocation.handler"),
+					resource.TestCheckResourceAttr(dataSourceName, "memory_size", "128"),
+					resource.TestCheckResourceAttr(dataSourceName, "timeout", "3"),
+					resource.TestCheckResourceAttr(dataSourceName, "version", "$LATEST"),
+					resource.TestMatchResourceAttr(dataSourceName, "qualified_arn", regexp.MustCompile(`:\$LATEST$`)),
+					resource.TestCheckResourceAttr(dataSourceName, "environment.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "environment.0.variables.foo", "bar"),
+					resource.TestCheckResourceAttr(dataSourceName, "vpc_config.#", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "tags.Name", rName),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceAWSLambdaFunction_alias(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	dataSourceName := "data.aws_lambda_function.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAWSLambdaFunctionConfigAlias(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "arn", "aws_lambda_function.test", "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "qualified_arn", "aws_lambda_alias.test", "arn"),
+					resource.TestCheckResourceAttr(dataSourceName, "version", "1"),
+					resource.TestMatchResourceAttr(dataSourceName, "invoke_arn", regexp.MustCompile(`:live/invocations$`)),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAWSLambdaFunctionConfigBase(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_iam_role" "test" {
+  name = "%[1]s"
+
+  assume_role_policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Action": "sts:AssumeRole",
+      "Principal": {
+        "Service": "lambda.amazonaws.com"
+      },
+      "Effect": "Allow"
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_lambda_function" "test" {
+  source_dir    = "test-fixtures/lambda_invocation"
+  function_name = "%[1]s"
+  role          = "${aws_iam_role.test.arn}"
+  handler       = "lambda_invocation.handler"
+  runtime       = "nodejs6.10"
+  publish       = true
+
+  environment {
+    variables {
+      foo = "bar"
+    }
+  }
+
+  tags {
+    Name = "%[1]s"
+  }
+}
+`, rName)
+}
+
+func testAccDataSourceAWSLambdaFunctionConfig(rName string) string {
+	return testAccDataSourceAWSLambdaFunctionConfigBase(rName) + `
+data "aws_lambda_function" "test" {
+  function_name = "${aws_lambda_function.test.function_name}"
+}
+`
+}
+
+func testAccDataSourceAWSLambdaFunctionConfigAlias(rName string) string {
+	return testAccDataSourceAWSLambdaFunctionConfigBase(rName) + `
+resource "aws_lambda_alias" "test" {
+  name             = "live"
+  function_name    = "${aws_lambda_function.test.arn}"
+  function_version = "${aws_lambda_function.test.version}"
+}
+
+data "aws_lambda_function" "test" {
+  function_name = "${aws_lambda_function.test.function_name}"
+  qualifier     = "${aws_lambda_alias.test.name}"
+}
+`
+}
@@ -0,0 +1,101 @@
+package aws
+
+import (
+	"crypto/md5"
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceAwsLambdaInvocation() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsLambdaInvocationRead,
+
+		Schema: map[string]*schema.Schema{
+			"function_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"qualifier": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "$LATEST",
+			},
+			"input": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateJsonString,
+			},
+			"result": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"result_map": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceAwsLambdaInvocationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lambdaconn
+
+	functionName := d.Get("function_name").(string)
+	qualifier := d.Get("qualifier").(string)
+	input := []byte(d.Get("input").(string))
+
+	log.Printf("[DEBUG] Invoking Lambda Function %s:%s", functionName, qualifier)
+	out, err := conn.Invoke(&lambda.InvokeInput{
+		FunctionName:   aws.String(functionName),
+		InvocationType: aws.String(lambda.InvocationTypeRequestResponse),
+		Payload:        input,
+		Qualifier:      aws.String(qualifier),
+	})
+	if err != nil {
+		return fmt.Errorf("Error invoking Lambda Function %s:%s: %s", functionName, qualifier, err)
+	}
+
+	if out.FunctionError != nil {
+		return fmt.Errorf("Lambda Function %s:%s returned %s error: %s",
+			functionName, qualifier, aws.StringValue(out.FunctionError), string(out.Payload))
+	}
+
+	d.SetId(fmt.Sprintf("%s_%s_%x", functionName, qualifier, md5.Sum(input)))
+	d.Set("result", string(out.Payload))
+	if err := d.Set("result_map", flattenLambdaInvocationResult(out.Payload)); err != nil {
+		return fmt.Errorf("Error setting result_map: %s", err)
+	}
+
+	return nil
+}
+
+// flattenLambdaInvocationResult turns a JSON object returned by a function
+// into a map of its top level keys. String values are used as they are and
+// any other value is re-encoded as JSON; nulls become empty strings. Results
+// which aren't JSON objects produce an empty map.
+func flattenLambdaInvocationResult(payload []byte) map[string]string {
+	result := make(map[string]string)
+
+	var object map[string]json.RawMessage
+	if err := json.Unmarshal(payload, &object); err != nil {
+		log.Printf("[DEBUG] Lambda Function result is not a JSON object, leaving result_map empty: %s", err)
+		return result
+	}
+
+	for k, raw := range object {
+		var s string
+		if err := json.Unmarshal(raw, &s); err == nil {
+			result[k] = s
+			continue
+		}
+		result[k] = string(raw)
+	}
+
+	return result
+}
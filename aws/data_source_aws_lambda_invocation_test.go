@@ -0,0 +1,112 @@
+package aws
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestFlattenLambdaInvocationResult(t *testing.T) {
+	cases := map[string]struct {
+		Payload  string
+		Expected map[string]string
+	}{
+		"object": {
+			Payload: `{"key1":"value1","count":2,"enabled":true,"nested":{"a":["b"]},"empty":null}`,
+			Expected: map[string]string{
+				"key1":    "value1",
+				"count":   "2",
+				"enabled": "true",
+				"nested":  `{"a":["b"]}`,
+				"empty":   "",
+			},
+		},
+		"string": {
+			Payload:  `"hello"`,
+			Expected: map[string]string{},
+		},
+		"array": {
+			Payload:  `[1, 2]`,
+			Expected: map[string]string{},
+		},
+		"not JSON": {
+			Payload:  `hello`,
+			Expected: map[string]string{},
+		},
+	}
+
+	for name, tc := range cases {
+		actual := flattenLambdaInvocationResult([]byte(tc.Payload))
+		if !reflect.DeepEqual(actual, tc.Expected) {
+			t.Errorf("%s: expected %v, got %v", name, tc.Expected, actual)
+		}
+	}
+}
+
+func TestAccDataSourceAWSLambdaInvocation_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAWSLambdaInvocationConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.aws_lambda_invocation.test", "result_map.%", "3"),
+					resource.TestCheckResourceAttr("data.aws_lambda_invocation.test", "result_map.key1", "value1"),
+					resource.TestCheckResourceAttr("data.aws_lambda_invocation.test", "result_map.key2", "value2"),
+					resource.TestCheckResourceAttr("data.aws_lambda_invocation.test", "result_map.nested", `{"key3":"value3"}`),
+					resource.TestCheckResourceAttr("data.aws_lambda_invocation.test", "result",
+						`{"key1":"value1","key2":"value2","nested":{"key3":"value3"}}`),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAWSLambdaInvocationConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_iam_role" "test" {
+  name = "%[1]s"
+
+  assume_role_policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Action": "sts:AssumeRole",
+      "Principal": {
+        "Service": "lambda.amazonaws.com"
+      },
+      "Effect": "Allow"
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_lambda_function" "test" {
+  source_dir    = "test-fixtures/lambda_invocation"
+  function_name = "%[1]s"
+  role          = "${aws_iam_role.test.arn}"
+  handler       = "lambda_invocation.handler"
+  runtime       = "nodejs6.10"
+}
+
+data "aws_lambda_invocation" "test" {
+  function_name = "${aws_lambda_function.test.function_name}"
+
+  input = <<JSON
+{
+  "key1": "value1",
+  "key2": "value2",
+  "key3": "value3"
+}
+JSON
+}
+`, rName)
+}
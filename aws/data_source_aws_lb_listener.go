@@ -46,6 +46,12 @@ func dataSourceAwsLbListener() *schema.Resource {
 				Computed: true,
 			},
 
+			"rule_priorities": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeInt},
+			},
+
 			"default_action": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -70,7 +76,7 @@ func dataSourceAwsLbListenerRead(d *schema.ResourceData, meta interface{}) error
 	if _, ok := d.GetOk("arn"); ok {
 		d.SetId(d.Get("arn").(string))
 		//log.Printf("[DEBUG] read listener %s", d.Get("arn").(string))
-		return resourceAwsLbListenerRead(d, meta)
+		return dataSourceAwsLbListenerReadWithRules(d, meta)
 	}
 
 	conn := meta.(*AWSClient).elbv2conn
@@ -92,9 +98,25 @@ func dataSourceAwsLbListenerRead(d *schema.ResourceData, meta interface{}) error
 		if *listener.Port == int64(port.(int)) {
 			//log.Printf("[DEBUG] get listener arn for %s:%s: %s", lbArn, port, *listener.Port)
 			d.SetId(*listener.ListenerArn)
-			return resourceAwsLbListenerRead(d, meta)
+			return dataSourceAwsLbListenerReadWithRules(d, meta)
 		}
 	}
 
 	return errors.New("failed to get listener arn with given arguments")
 }
+
+func dataSourceAwsLbListenerReadWithRules(d *schema.ResourceData, meta interface{}) error {
+	if err := resourceAwsLbListenerRead(d, meta); err != nil {
+		return err
+	}
+
+	priorities, err := listenerRulePriorities(meta.(*AWSClient).elbv2conn, d.Id())
+	if err != nil {
+		return fmt.Errorf("Error describing rules for LB Listener (%s): %s", d.Id(), err)
+	}
+	if err := d.Set("rule_priorities", priorities); err != nil {
+		return fmt.Errorf("Error setting rule_priorities: %s", err)
+	}
+
+	return nil
+}
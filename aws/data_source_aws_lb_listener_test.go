@@ -28,6 +28,7 @@ func TestAccDataSourceAWSLBListener_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("data.aws_lb_listener.front_end", "port", "80"),
 					resource.TestCheckResourceAttr("data.aws_lb_listener.front_end", "default_action.#", "1"),
 					resource.TestCheckResourceAttr("data.aws_lb_listener.front_end", "default_action.0.type", "forward"),
+					resource.TestCheckResourceAttr("data.aws_lb_listener.front_end", "rule_priorities.#", "0"),
 					resource.TestCheckResourceAttrSet("data.aws_lb_listener.from_lb_and_port", "load_balancer_arn"),
 					resource.TestCheckResourceAttrSet("data.aws_lb_listener.from_lb_and_port", "arn"),
 					resource.TestCheckResourceAttrSet("data.aws_lb_listener.from_lb_and_port", "default_action.0.target_group_arn"),
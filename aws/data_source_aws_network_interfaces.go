@@ -0,0 +1,70 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"sort"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceAwsNetworkInterfaces() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsNetworkInterfacesRead,
+		Schema: map[string]*schema.Schema{
+			"filter": ec2CustomFiltersSchema(),
+
+			"tags": tagsSchemaComputed(),
+
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceAwsNetworkInterfacesRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	req := &ec2.DescribeNetworkInterfacesInput{}
+
+	req.Filters = append(req.Filters, buildEC2TagFilterList(
+		tagsFromMap(d.Get("tags").(map[string]interface{})),
+	)...)
+	req.Filters = append(req.Filters, buildEC2CustomFilterList(
+		d.Get("filter").(*schema.Set),
+	)...)
+	if len(req.Filters) == 0 {
+		// Don't send an empty filters list; the EC2 API won't accept it.
+		req.Filters = nil
+	}
+
+	log.Printf("[DEBUG] DescribeNetworkInterfaces %s\n", req)
+	resp, err := conn.DescribeNetworkInterfaces(req)
+	if err != nil {
+		return err
+	}
+
+	if resp == nil || len(resp.NetworkInterfaces) == 0 {
+		return fmt.Errorf("no matching network interface found")
+	}
+
+	ids := make([]string, 0, len(resp.NetworkInterfaces))
+	for _, eni := range resp.NetworkInterfaces {
+		ids = append(ids, *eni.NetworkInterfaceId)
+	}
+	sort.Strings(ids)
+
+	log.Printf("[DEBUG] Found %d network interfaces via given filter", len(ids))
+
+	d.SetId(resource.UniqueId())
+	if err := d.Set("ids", ids); err != nil {
+		return fmt.Errorf("Error setting network interface ids: %s", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,75 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceAwsNetworkInterfaces_basic(t *testing.T) {
+	rName := acctest.RandString(5)
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAwsNetworkInterfacesConfig(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.aws_network_interfaces.by_tag", "ids.#", "1"),
+					resource.TestCheckResourceAttrPair("data.aws_network_interfaces.by_tag", "ids.0", "aws_network_interface.a", "id"),
+					resource.TestCheckResourceAttr("data.aws_network_interfaces.by_filter", "ids.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAwsNetworkInterfacesConfig(rName string) string {
+	return fmt.Sprintf(`
+data "aws_availability_zones" "available" {}
+
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+  tags {
+    Name = "terraform-testacc-enis-data-source"
+  }
+}
+
+resource "aws_subnet" "test" {
+  cidr_block        = "10.0.0.0/24"
+  availability_zone = "${data.aws_availability_zones.available.names[0]}"
+  vpc_id            = "${aws_vpc.test.id}"
+}
+
+resource "aws_network_interface" "a" {
+  subnet_id = "${aws_subnet.test.id}"
+  tags {
+    Name = "tf-acc-eni-a-%s"
+  }
+}
+
+resource "aws_network_interface" "b" {
+  subnet_id = "${aws_subnet.test.id}"
+  tags {
+    Name = "tf-acc-eni-b-%s"
+  }
+}
+
+data "aws_network_interfaces" "by_tag" {
+  tags {
+    Name = "${aws_network_interface.a.tags.Name}"
+  }
+}
+
+data "aws_network_interfaces" "by_filter" {
+  filter {
+    name   = "subnet-id"
+    values = ["${aws_subnet.test.id}"]
+  }
+
+  depends_on = ["aws_network_interface.a", "aws_network_interface.b"]
+}
+`, rName, rName)
+}
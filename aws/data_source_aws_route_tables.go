@@ -0,0 +1,80 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"sort"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceAwsRouteTables() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsRouteTablesRead,
+		Schema: map[string]*schema.Schema{
+			"filter": ec2CustomFiltersSchema(),
+
+			"tags": tagsSchemaComputed(),
+
+			"vpc_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceAwsRouteTablesRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	req := &ec2.DescribeRouteTablesInput{}
+
+	req.Filters = buildEC2AttributeFilterList(
+		map[string]string{
+			"vpc-id": d.Get("vpc_id").(string),
+		},
+	)
+	req.Filters = append(req.Filters, buildEC2TagFilterList(
+		tagsFromMap(d.Get("tags").(map[string]interface{})),
+	)...)
+	req.Filters = append(req.Filters, buildEC2CustomFilterList(
+		d.Get("filter").(*schema.Set),
+	)...)
+	if len(req.Filters) == 0 {
+		// Don't send an empty filters list; the EC2 API won't accept it.
+		req.Filters = nil
+	}
+
+	log.Printf("[DEBUG] DescribeRouteTables %s\n", req)
+	resp, err := conn.DescribeRouteTables(req)
+	if err != nil {
+		return err
+	}
+
+	if resp == nil || len(resp.RouteTables) == 0 {
+		return fmt.Errorf("no matching route table found")
+	}
+
+	ids := make([]string, 0, len(resp.RouteTables))
+	for _, rt := range resp.RouteTables {
+		ids = append(ids, *rt.RouteTableId)
+	}
+	sort.Strings(ids)
+
+	log.Printf("[DEBUG] Found %d route tables via given filter", len(ids))
+
+	d.SetId(resource.UniqueId())
+	if err := d.Set("ids", ids); err != nil {
+		return fmt.Errorf("Error setting route table ids: %s", err)
+	}
+
+	return nil
+}
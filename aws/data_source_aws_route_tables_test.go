@@ -0,0 +1,71 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceAwsRouteTables_basic(t *testing.T) {
+	rName := acctest.RandString(5)
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAwsRouteTablesConfig(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// The VPC's main route table is matched as well.
+					resource.TestCheckResourceAttr("data.aws_route_tables.by_vpc", "ids.#", "3"),
+					resource.TestCheckResourceAttr("data.aws_route_tables.by_tag", "ids.#", "1"),
+					resource.TestCheckResourceAttrPair("data.aws_route_tables.by_tag", "ids.0", "aws_route_table.private", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAwsRouteTablesConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+  tags {
+    Name = "terraform-testacc-route-tables-data-source"
+  }
+}
+
+resource "aws_route_table" "public" {
+  vpc_id = "${aws_vpc.test.id}"
+  tags {
+    Tier = "Public"
+    Name = "tf-acc-rt-public-%s"
+  }
+}
+
+resource "aws_route_table" "private" {
+  vpc_id = "${aws_vpc.test.id}"
+  tags {
+    Tier = "Private"
+    Name = "tf-acc-rt-private-%s"
+  }
+}
+
+data "aws_route_tables" "by_vpc" {
+  vpc_id = "${aws_vpc.test.id}"
+
+  depends_on = ["aws_route_table.public", "aws_route_table.private"]
+}
+
+data "aws_route_tables" "by_tag" {
+  vpc_id = "${aws_vpc.test.id}"
+
+  tags {
+    Tier = "Private"
+  }
+
+  depends_on = ["aws_route_table.public", "aws_route_table.private"]
+}
+`, rName, rName)
+}
@@ -5,8 +5,11 @@ import (
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/errwrap"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/structure"
 )
 
 func dataSourceAwsS3Bucket() *schema.Resource {
@@ -42,6 +45,274 @@ func dataSourceAwsS3Bucket() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"policy": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"versioning": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"mfa_delete": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"logging": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"target_bucket": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"target_prefix": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"lifecycle_rule": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"prefix": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"tags": {
+							Type:     schema.TypeMap,
+							Computed: true,
+						},
+						"enabled": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"abort_incomplete_multipart_upload_days": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"expiration": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Set:      expirationHash,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"date": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"days": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+									"expired_object_delete_marker": {
+										Type:     schema.TypeBool,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"noncurrent_version_expiration": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Set:      expirationHash,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"days": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"transition": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Set:      transitionHash,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"date": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"days": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+									"storage_class": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"noncurrent_version_transition": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Set:      transitionHash,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"days": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+									"storage_class": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"server_side_encryption_configuration": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"rule": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"apply_server_side_encryption_by_default": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"kms_master_key_id": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+												"sse_algorithm": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"replication_configuration": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"role": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"rules": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Set:      rulesHash,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"prefix": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"status": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"destination": {
+										Type:     schema.TypeSet,
+										Computed: true,
+										Set:      destinationHash,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"bucket": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+												"storage_class": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+												"replica_kms_key_id": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+											},
+										},
+									},
+									"source_selection_criteria": {
+										Type:     schema.TypeSet,
+										Computed: true,
+										Set:      sourceSelectionCriteriaHash,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"sse_kms_encrypted_objects": {
+													Type:     schema.TypeSet,
+													Computed: true,
+													Set:      sourceSseKmsObjectsHash,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"enabled": {
+																Type:     schema.TypeBool,
+																Computed: true,
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"website": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"index_document": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"error_document": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"redirect_all_requests_to": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"routing_rules": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -70,9 +341,152 @@ func dataSourceAwsS3BucketRead(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 
+	if err := dataSourceAwsS3BucketReadConfiguration(d, bucket, conn); err != nil {
+		return fmt.Errorf("Failed reading configuration of S3 bucket %q: %s", bucket, err)
+	}
+
 	return nil
 }
 
+// dataSourceAwsS3BucketReadConfiguration reads the optional sub-configurations
+// of the bucket, leaving the matching attribute empty when one isn't set.
+func dataSourceAwsS3BucketReadConfiguration(d *schema.ResourceData, bucket string, conn *s3.S3) error {
+	// Policy
+	policy := ""
+	pol, err := conn.GetBucketPolicy(&s3.GetBucketPolicyInput{
+		Bucket: aws.String(bucket),
+	})
+	if err != nil {
+		if !isAWSErr(err, "NoSuchBucketPolicy", "") && !isS3BucketConfigurationAccessDenied(err, bucket, "policy") {
+			return err
+		}
+	} else if v := pol.Policy; v != nil {
+		policy, err = structure.NormalizeJsonString(*v)
+		if err != nil {
+			return errwrap.Wrapf("policy contains an invalid JSON: {{err}}", err)
+		}
+	}
+	d.Set("policy", policy)
+
+	// Versioning
+	versioning, err := conn.GetBucketVersioning(&s3.GetBucketVersioningInput{
+		Bucket: aws.String(bucket),
+	})
+	vcl := make([]map[string]interface{}, 0, 1)
+	if err != nil {
+		if !isS3BucketConfigurationAccessDenied(err, bucket, "versioning") {
+			return err
+		}
+	} else {
+		vcl = append(vcl, flattenS3BucketVersioning(versioning))
+	}
+	if err := d.Set("versioning", vcl); err != nil {
+		return err
+	}
+
+	// Logging
+	logging, err := conn.GetBucketLogging(&s3.GetBucketLoggingInput{
+		Bucket: aws.String(bucket),
+	})
+	lcl := make([]map[string]interface{}, 0, 1)
+	if err != nil {
+		if !isS3BucketConfigurationAccessDenied(err, bucket, "logging") {
+			return err
+		}
+	} else if v := logging.LoggingEnabled; v != nil {
+		lcl = append(lcl, flattenS3BucketLogging(v))
+	}
+	if err := d.Set("logging", lcl); err != nil {
+		return err
+	}
+
+	// Lifecycle
+	var rules []map[string]interface{}
+	lifecycle, err := conn.GetBucketLifecycleConfiguration(&s3.GetBucketLifecycleConfigurationInput{
+		Bucket: aws.String(bucket),
+	})
+	if err != nil {
+		if !isAWSErr(err, "NoSuchLifecycleConfiguration", "") && !isS3BucketConfigurationAccessDenied(err, bucket, "lifecycle") {
+			return err
+		}
+	} else {
+		rules = flattenS3LifecycleRules(lifecycle.Rules)
+	}
+	if err := d.Set("lifecycle_rule", rules); err != nil {
+		return err
+	}
+
+	// Server-side encryption
+	encryptionConfiguration := make([]map[string]interface{}, 0, 1)
+	encryption, err := conn.GetBucketEncryption(&s3.GetBucketEncryptionInput{
+		Bucket: aws.String(bucket),
+	})
+	if err != nil {
+		if !isAWSErr(err, "ServerSideEncryptionConfigurationNotFoundError", "") && !isS3BucketConfigurationAccessDenied(err, bucket, "encryption") {
+			return err
+		}
+	} else if c := encryption.ServerSideEncryptionConfiguration; c != nil {
+		encryptionConfiguration = flattenAwsS3ServerSideEncryptionConfiguration(c)
+	}
+	if err := d.Set("server_side_encryption_configuration", encryptionConfiguration); err != nil {
+		return err
+	}
+
+	// Replication
+	var replicationConfiguration *s3.ReplicationConfiguration
+	replication, err := conn.GetBucketReplication(&s3.GetBucketReplicationInput{
+		Bucket: aws.String(bucket),
+	})
+	if err != nil {
+		if !isAWSErr(err, "ReplicationConfigurationNotFoundError", "") && !isS3BucketConfigurationAccessDenied(err, bucket, "replication") {
+			return err
+		}
+	} else {
+		replicationConfiguration = replication.ReplicationConfiguration
+	}
+	if err := d.Set("replication_configuration", flattenAwsS3BucketReplicationConfiguration(replicationConfiguration)); err != nil {
+		return err
+	}
+
+	// Website
+	websites := make([]map[string]interface{}, 0, 1)
+	ws, err := conn.GetBucketWebsite(&s3.GetBucketWebsiteInput{
+		Bucket: aws.String(bucket),
+	})
+	if err != nil {
+		if !isAWSErr(err, "NotImplemented", "") && !isAWSErr(err, "NoSuchWebsiteConfiguration", "") &&
+			!isS3BucketConfigurationAccessDenied(err, bucket, "website") {
+			return err
+		}
+	} else {
+		w, err := flattenS3BucketWebsite(ws)
+		if err != nil {
+			return err
+		}
+		websites = append(websites, w)
+	}
+	if err := d.Set("website", websites); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// isS3BucketConfigurationAccessDenied returns true, and logs a warning, if
+// reading one of the bucket's configurations was refused. This is common for
+// buckets shared from another account, so the configuration is left empty
+// rather than failing the whole data source.
+func isS3BucketConfigurationAccessDenied(err error, bucket, configuration string) bool {
+	denied := isAWSErr(err, "AccessDenied", "")
+	if reqErr, ok := err.(awserr.RequestFailure); ok && reqErr.StatusCode() == 403 {
+		denied = true
+	}
+	if denied {
+		log.Printf("[WARN] Unable to read %s configuration of S3 Bucket (%s), leaving it empty: %s", configuration, bucket, err)
+	}
+	return denied
+}
+
 func bucketLocation(d *schema.ResourceData, bucket string, conn *s3.S3) error {
 	location, err := conn.GetBucketLocation(
 		&s3.GetBucketLocationInput{
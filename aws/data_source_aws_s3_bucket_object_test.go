@@ -159,8 +159,10 @@ func TestAccDataSourceAWSS3BucketObject_allParams(t *testing.T) {
 					// Currently unsupported in aws_s3_bucket_object resource
 					resource.TestCheckResourceAttr("data.aws_s3_bucket_object.obj", "expires", ""),
 					resource.TestCheckResourceAttr("data.aws_s3_bucket_object.obj", "website_redirect_location", ""),
-					resource.TestCheckResourceAttr("data.aws_s3_bucket_object.obj", "metadata.%", "0"),
+					resource.TestCheckResourceAttr("data.aws_s3_bucket_object.obj", "metadata.%", "1"),
+					resource.TestCheckResourceAttr("data.aws_s3_bucket_object.obj", "metadata.Owner", "data-lake"),
 					resource.TestCheckResourceAttr("data.aws_s3_bucket_object.obj", "tags.%", "1"),
+					resource.TestCheckResourceAttr("data.aws_s3_bucket_object.obj", "tags.Key1", "Value 1"),
 				),
 			},
 		},
@@ -285,6 +287,9 @@ CONTENT
 	content_disposition = "attachment"
 	content_encoding = "identity"
 	content_language = "en-GB"
+	metadata {
+		owner = "data-lake"
+	}
 	tags {
 		Key1 = "Value 1"
 	}
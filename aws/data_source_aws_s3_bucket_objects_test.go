@@ -0,0 +1,145 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceAWSS3BucketObjects_basic(t *testing.T) {
+	rInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                  func() { testAccPreCheck(t) },
+		Providers:                 testAccProviders,
+		PreventPostDestroyRefresh: true,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSDataSourceS3ObjectsConfigResources(rInt), // NOTE: contains no data source
+				// Does not need Check
+			},
+			{
+				Config: testAccAWSDataSourceS3ObjectsConfigBasic(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.aws_s3_bucket_objects.yesh", "keys.#", "2"),
+					resource.TestCheckResourceAttr("data.aws_s3_bucket_objects.yesh", "keys.0", "arch/navajo/north_window"),
+					resource.TestCheckResourceAttr("data.aws_s3_bucket_objects.yesh", "keys.1", "arch/navajo/sand_dune"),
+					resource.TestCheckResourceAttr("data.aws_s3_bucket_objects.yesh", "owners.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceAWSS3BucketObjects_delimiter(t *testing.T) {
+	rInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                  func() { testAccPreCheck(t) },
+		Providers:                 testAccProviders,
+		PreventPostDestroyRefresh: true,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSDataSourceS3ObjectsConfigResources(rInt), // NOTE: contains no data source
+				// Does not need Check
+			},
+			{
+				Config: testAccAWSDataSourceS3ObjectsConfigDelimiter(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.aws_s3_bucket_objects.yesh", "keys.#", "0"),
+					resource.TestCheckResourceAttr("data.aws_s3_bucket_objects.yesh", "common_prefixes.#", "2"),
+					resource.TestCheckResourceAttr("data.aws_s3_bucket_objects.yesh", "common_prefixes.0", "arch/courthouse_towers/"),
+					resource.TestCheckResourceAttr("data.aws_s3_bucket_objects.yesh", "common_prefixes.1", "arch/navajo/"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceAWSS3BucketObjects_maxKeysAndOwner(t *testing.T) {
+	rInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                  func() { testAccPreCheck(t) },
+		Providers:                 testAccProviders,
+		PreventPostDestroyRefresh: true,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSDataSourceS3ObjectsConfigResources(rInt), // NOTE: contains no data source
+				// Does not need Check
+			},
+			{
+				Config: testAccAWSDataSourceS3ObjectsConfigMaxKeysAndOwner(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.aws_s3_bucket_objects.yesh", "keys.#", "1"),
+					resource.TestCheckResourceAttr("data.aws_s3_bucket_objects.yesh", "keys.0", "arch/courthouse_towers/landscape"),
+					resource.TestCheckResourceAttr("data.aws_s3_bucket_objects.yesh", "owners.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAWSDataSourceS3ObjectsConfigResources(randInt int) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "objects_bucket" {
+  bucket = "tf-objects-test-bucket-%d"
+}
+
+resource "aws_s3_bucket_object" "object1" {
+  bucket  = "${aws_s3_bucket.objects_bucket.id}"
+  key     = "arch/navajo/north_window"
+  content = "Delicate"
+}
+
+resource "aws_s3_bucket_object" "object2" {
+  bucket  = "${aws_s3_bucket.objects_bucket.id}"
+  key     = "arch/navajo/sand_dune"
+  content = "Landscape"
+}
+
+resource "aws_s3_bucket_object" "object3" {
+  bucket  = "${aws_s3_bucket.objects_bucket.id}"
+  key     = "arch/courthouse_towers/landscape"
+  content = "Park Avenue"
+}
+`, randInt)
+}
+
+func testAccAWSDataSourceS3ObjectsConfigBasic(randInt int) string {
+	return fmt.Sprintf(`
+%s
+
+data "aws_s3_bucket_objects" "yesh" {
+  bucket = "${aws_s3_bucket.objects_bucket.id}"
+  prefix = "arch/navajo/"
+}
+`, testAccAWSDataSourceS3ObjectsConfigResources(randInt))
+}
+
+func testAccAWSDataSourceS3ObjectsConfigDelimiter(randInt int) string {
+	return fmt.Sprintf(`
+%s
+
+data "aws_s3_bucket_objects" "yesh" {
+  bucket    = "${aws_s3_bucket.objects_bucket.id}"
+  prefix    = "arch/"
+  delimiter = "/"
+}
+`, testAccAWSDataSourceS3ObjectsConfigResources(randInt))
+}
+
+func testAccAWSDataSourceS3ObjectsConfigMaxKeysAndOwner(randInt int) string {
+	return fmt.Sprintf(`
+%s
+
+data "aws_s3_bucket_objects" "yesh" {
+  bucket      = "${aws_s3_bucket.objects_bucket.id}"
+  prefix      = "arch/"
+  max_keys    = 1
+  fetch_owner = true
+}
+`, testAccAWSDataSourceS3ObjectsConfigResources(randInt))
+}
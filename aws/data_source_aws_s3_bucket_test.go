@@ -1,10 +1,12 @@
 package aws
 
 import (
+	"errors"
 	"fmt"
 	"regexp"
 	"testing"
 
+	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 )
@@ -57,6 +59,34 @@ func TestAccDataSourceS3Bucket_website(t *testing.T) {
 	})
 }
 
+func TestAccDataSourceS3Bucket_configuration(t *testing.T) {
+	rInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSDataSourceS3BucketConfig_configuration(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr("data.aws_s3_bucket.bucket", "policy", regexp.MustCompile("s3:GetObject")),
+					resource.TestCheckResourceAttr("data.aws_s3_bucket.bucket", "versioning.#", "1"),
+					resource.TestCheckResourceAttr("data.aws_s3_bucket.bucket", "versioning.0.enabled", "true"),
+					resource.TestCheckResourceAttr("data.aws_s3_bucket.bucket", "logging.#", "1"),
+					resource.TestCheckResourceAttr("data.aws_s3_bucket.bucket", "logging.0.target_prefix", "log/"),
+					resource.TestCheckResourceAttr("data.aws_s3_bucket.bucket", "lifecycle_rule.#", "1"),
+					resource.TestCheckResourceAttr("data.aws_s3_bucket.bucket", "lifecycle_rule.0.id", "logs"),
+					resource.TestCheckResourceAttr("data.aws_s3_bucket.bucket", "lifecycle_rule.0.prefix", "logs/"),
+					resource.TestCheckResourceAttr("data.aws_s3_bucket.bucket", "server_side_encryption_configuration.#", "1"),
+					resource.TestCheckResourceAttr("data.aws_s3_bucket.bucket", "server_side_encryption_configuration.0.rule.0.apply_server_side_encryption_by_default.0.sse_algorithm", "AES256"),
+					resource.TestCheckResourceAttr("data.aws_s3_bucket.bucket", "replication_configuration.#", "0"),
+					resource.TestCheckResourceAttr("data.aws_s3_bucket.bucket", "website.#", "0"),
+				),
+			},
+		},
+	})
+}
+
 func testAccAWSDataSourceS3BucketConfig_basic(randInt int) string {
 	return fmt.Sprintf(`
 resource "aws_s3_bucket" "bucket" {
@@ -84,3 +114,95 @@ data "aws_s3_bucket" "bucket" {
 	bucket = "${aws_s3_bucket.bucket.id}"
 }`, randInt)
 }
+
+func testAccAWSDataSourceS3BucketConfig_configuration(randInt int) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "log_bucket" {
+	bucket = "tf-test-log-bucket-%[1]d"
+	acl = "log-delivery-write"
+	force_destroy = true
+}
+
+resource "aws_s3_bucket" "bucket" {
+	bucket = "tf-test-bucket-%[1]d"
+
+	policy = <<POLICY
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": "*",
+      "Action": "s3:GetObject",
+      "Resource": "arn:aws:s3:::tf-test-bucket-%[1]d/*"
+    }
+  ]
+}
+POLICY
+
+	versioning {
+		enabled = true
+	}
+
+	logging {
+		target_bucket = "${aws_s3_bucket.log_bucket.id}"
+		target_prefix = "log/"
+	}
+
+	lifecycle_rule {
+		id = "logs"
+		prefix = "logs/"
+		enabled = true
+
+		expiration {
+			days = 90
+		}
+	}
+
+	server_side_encryption_configuration {
+		rule {
+			apply_server_side_encryption_by_default {
+				sse_algorithm = "AES256"
+			}
+		}
+	}
+}
+
+data "aws_s3_bucket" "bucket" {
+	bucket = "${aws_s3_bucket.bucket.id}"
+}`, randInt)
+}
+
+func TestIsS3BucketConfigurationAccessDenied(t *testing.T) {
+	testCases := []struct {
+		Err      error
+		Expected bool
+	}{
+		{
+			Err:      awserr.New("AccessDenied", "Access Denied", nil),
+			Expected: true,
+		},
+		{
+			Err:      awserr.NewRequestFailure(awserr.New("Forbidden", "Forbidden", nil), 403, "request-id"),
+			Expected: true,
+		},
+		{
+			Err:      awserr.NewRequestFailure(awserr.New("NoSuchBucketPolicy", "The bucket policy does not exist", nil), 404, "request-id"),
+			Expected: false,
+		},
+		{
+			Err:      awserr.New("InternalError", "We encountered an internal error", nil),
+			Expected: false,
+		},
+		{
+			Err:      errors.New("connection reset"),
+			Expected: false,
+		},
+	}
+
+	for i, tc := range testCases {
+		if actual := isS3BucketConfigurationAccessDenied(tc.Err, "tf-test-bucket", "policy"); actual != tc.Expected {
+			t.Fatalf("Case #%d: expected %t, got %t", i, tc.Expected, actual)
+		}
+	}
+}
@@ -0,0 +1,97 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"sort"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceAwsSecurityGroups() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsSecurityGroupsRead,
+		Schema: map[string]*schema.Schema{
+			"filter": ec2CustomFiltersSchema(),
+
+			"tags": tagsSchemaComputed(),
+
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"vpc_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceAwsSecurityGroupsRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	req := &ec2.DescribeSecurityGroupsInput{
+		MaxResults: aws.Int64(1000),
+	}
+
+	req.Filters = append(req.Filters, buildEC2TagFilterList(
+		tagsFromMap(d.Get("tags").(map[string]interface{})),
+	)...)
+	req.Filters = append(req.Filters, buildEC2CustomFilterList(
+		d.Get("filter").(*schema.Set),
+	)...)
+	if len(req.Filters) == 0 {
+		// Don't send an empty filters list; the EC2 API won't accept it.
+		req.Filters = nil
+	}
+
+	log.Printf("[DEBUG] DescribeSecurityGroups %s\n", req)
+
+	var groups []*ec2.SecurityGroup
+	for {
+		resp, err := conn.DescribeSecurityGroups(req)
+		if err != nil {
+			return err
+		}
+		groups = append(groups, resp.SecurityGroups...)
+
+		if resp.NextToken == nil || *resp.NextToken == "" {
+			break
+		}
+		req.NextToken = resp.NextToken
+	}
+
+	if len(groups) == 0 {
+		return fmt.Errorf("no matching security group found")
+	}
+
+	sort.Slice(groups, func(i, j int) bool {
+		return *groups[i].GroupId < *groups[j].GroupId
+	})
+
+	ids := make([]string, 0, len(groups))
+	vpcIds := make([]string, 0, len(groups))
+	for _, group := range groups {
+		ids = append(ids, *group.GroupId)
+		vpcIds = append(vpcIds, aws.StringValue(group.VpcId))
+	}
+
+	log.Printf("[DEBUG] Found %d security groups via given filter", len(ids))
+
+	d.SetId(resource.UniqueId())
+	if err := d.Set("ids", ids); err != nil {
+		return fmt.Errorf("Error setting security group ids: %s", err)
+	}
+	if err := d.Set("vpc_ids", vpcIds); err != nil {
+		return fmt.Errorf("Error setting security group vpc_ids: %s", err)
+	}
+
+	return nil
+}
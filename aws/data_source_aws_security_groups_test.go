@@ -0,0 +1,73 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceAwsSecurityGroups_basic(t *testing.T) {
+	rName := acctest.RandString(5)
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAwsSecurityGroupsConfig(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.aws_security_groups.by_tag", "ids.#", "2"),
+					resource.TestCheckResourceAttr("data.aws_security_groups.by_tag", "vpc_ids.#", "2"),
+					resource.TestCheckResourceAttrPair("data.aws_security_groups.by_tag", "vpc_ids.0", "aws_vpc.test", "id"),
+					// The VPC's default security group is matched as well.
+					resource.TestCheckResourceAttr("data.aws_security_groups.by_filter", "ids.#", "3"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAwsSecurityGroupsConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+  tags {
+    Name = "terraform-testacc-security-groups-data-source"
+  }
+}
+
+resource "aws_security_group" "a" {
+  name   = "tf-sg-a-%s"
+  vpc_id = "${aws_vpc.test.id}"
+  tags {
+    Group = "tf-acc-%s"
+  }
+}
+
+resource "aws_security_group" "b" {
+  name   = "tf-sg-b-%s"
+  vpc_id = "${aws_vpc.test.id}"
+  tags {
+    Group = "tf-acc-%s"
+  }
+}
+
+data "aws_security_groups" "by_tag" {
+  tags {
+    Group = "tf-acc-%s"
+  }
+
+  depends_on = ["aws_security_group.a", "aws_security_group.b"]
+}
+
+data "aws_security_groups" "by_filter" {
+  filter {
+    name   = "vpc-id"
+    values = ["${aws_vpc.test.id}"]
+  }
+
+  depends_on = ["aws_security_group.a", "aws_security_group.b"]
+}
+`, rName, rName, rName, rName, rName)
+}
@@ -0,0 +1,67 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceAwsVpcs_basic(t *testing.T) {
+	rName := acctest.RandString(5)
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAwsVpcsConfig(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.aws_vpcs.by_tag", "ids.#", "2"),
+					resource.TestCheckResourceAttr("data.aws_vpcs.by_filter", "ids.#", "1"),
+					resource.TestCheckResourceAttrPair("data.aws_vpcs.by_filter", "ids.0", "aws_vpc.a", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAwsVpcsConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "a" {
+  cidr_block = "10.1.0.0/16"
+  tags {
+    Name = "terraform-testacc-vpcs-data-source-a"
+    Group = "tf-acc-%s"
+  }
+}
+
+resource "aws_vpc" "b" {
+  cidr_block = "10.2.0.0/16"
+  tags {
+    Name = "terraform-testacc-vpcs-data-source-b"
+    Group = "tf-acc-%s"
+  }
+}
+
+data "aws_vpcs" "by_tag" {
+  tags {
+    Group = "${aws_vpc.a.tags.Group}"
+  }
+
+  depends_on = ["aws_vpc.b"]
+}
+
+data "aws_vpcs" "by_filter" {
+  filter {
+    name   = "cidr"
+    values = ["${aws_vpc.a.cidr_block}"]
+  }
+
+  filter {
+    name   = "tag:Group"
+    values = ["${aws_vpc.a.tags.Group}"]
+  }
+}
+`, rName, rName)
+}
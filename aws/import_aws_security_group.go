@@ -2,20 +2,36 @@ package aws
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/errwrap"
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
-// Security group import fans out to multiple resources due to the
-// security group rules. Instead of creating one resource with nested
-// rules, we use the best practices approach of one resource per rule.
+// Security group import fans out to one aws_security_group_rule resource per
+// rule by default, with the group's rule_management set to "external" so that
+// the rules are owned by those resources only. Importing "<group id>/inline"
+// instead imports the group alone, with its rules owned by the inline ingress
+// and egress blocks. "<group id>/external" is accepted as an explicit form of
+// the default.
 func resourceAwsSecurityGroupImportState(
 	d *schema.ResourceData,
 	meta interface{}) ([]*schema.ResourceData, error) {
 	conn := meta.(*AWSClient).ec2conn
 
+	ruleManagement := securityGroupRuleManagementExternal
+	if parts := strings.SplitN(d.Id(), "/", 2); len(parts) == 2 {
+		switch parts[1] {
+		case securityGroupRuleManagementInline, securityGroupRuleManagementExternal:
+			d.SetId(parts[0])
+			ruleManagement = parts[1]
+		default:
+			return nil, fmt.Errorf("unexpected format of ID (%s), expected <security-group-id>[/inline|/external]", d.Id())
+		}
+	}
+	d.Set("rule_management", ruleManagement)
+
 	// First query the security group
 	sgRaw, _, err := SGStateRefreshFunc(conn, d.Id())()
 	if err != nil {
@@ -31,6 +47,10 @@ func resourceAwsSecurityGroupImportState(
 		1+len(sg.IpPermissions)+len(sg.IpPermissionsEgress))
 	results[0] = d
 
+	if ruleManagement == securityGroupRuleManagementInline {
+		return results, nil
+	}
+
 	// Construct the rules
 	permMap := map[string][]*ec2.IpPermission{
 		"ingress": sg.IpPermissions,
@@ -182,5 +202,8 @@ func resourceAwsSecurityGroupImportStatePermPair(sg *ec2.SecurityGroup, ruleType
 		return nil, errwrap.Wrapf("Error importing AWS Security Group: {{err}}", err)
 	}
 
+	// Without the description the first refresh would see a changed rule.
+	d.Set("description", descriptionFromIPPerm(d, perm))
+
 	return d, nil
 }
@@ -15,6 +15,44 @@ func TestAccAWSSecurityGroup_importBasic(t *testing.T) {
 			return fmt.Errorf("expected 2 states: %#v", s)
 		}
 
+		// The imported rules are owned by aws_security_group_rule resources.
+		for _, is := range s {
+			if is.Ephemeral.Type == "aws_security_group" {
+				if v := is.Attributes["rule_management"]; v != "external" {
+					return fmt.Errorf("expected rule_management to be external, got %q", v)
+				}
+			}
+		}
+
+		return nil
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSecurityGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSecurityGroupConfig,
+			},
+
+			{
+				ResourceName:            "aws_security_group.web",
+				ImportState:             true,
+				ImportStateCheck:        checkFn,
+				ImportStateVerifyIgnore: []string{"revoke_rules_on_delete", "rule_management"},
+			},
+		},
+	})
+}
+
+func TestAccAWSSecurityGroup_importInline(t *testing.T) {
+	checkFn := func(s []*terraform.InstanceState) error {
+		// Expect 1: group, rules are managed inline
+		if len(s) != 1 {
+			return fmt.Errorf("expected 1 state: %#v", s)
+		}
+
 		return nil
 	}
 
@@ -30,13 +68,52 @@ func TestAccAWSSecurityGroup_importBasic(t *testing.T) {
 			{
 				ResourceName:            "aws_security_group.web",
 				ImportState:             true,
+				ImportStateIdFunc:       testAccAWSSecurityGroupImportStateIdFunc("aws_security_group.web", "inline"),
 				ImportStateCheck:        checkFn,
+				ImportStateVerify:       true,
 				ImportStateVerifyIgnore: []string{"revoke_rules_on_delete"},
 			},
 		},
 	})
 }
 
+func TestAccAWSSecurityGroup_importExternal(t *testing.T) {
+	checkFn := func(s []*terraform.InstanceState) error {
+		// Expect 2: group, 1 rule
+		if len(s) != 2 {
+			return fmt.Errorf("expected 2 states: %#v", s)
+		}
+
+		for _, is := range s {
+			if is.Ephemeral.Type == "aws_security_group" {
+				if v := is.Attributes["rule_management"]; v != "external" {
+					return fmt.Errorf("expected rule_management to be external, got %q", v)
+				}
+			}
+		}
+
+		return nil
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSecurityGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSecurityGroupConfig,
+			},
+
+			{
+				ResourceName:      "aws_security_group.web",
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSSecurityGroupImportStateIdFunc("aws_security_group.web", "external"),
+				ImportStateCheck:  checkFn,
+			},
+		},
+	})
+}
+
 func TestAccAWSSecurityGroup_importIpv6(t *testing.T) {
 	checkFn := func(s []*terraform.InstanceState) error {
 		// Expect 3: group, 2 rules
@@ -79,7 +156,7 @@ func TestAccAWSSecurityGroup_importSelf(t *testing.T) {
 				ResourceName:            "aws_security_group.allow_all",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"revoke_rules_on_delete"},
+				ImportStateVerifyIgnore: []string{"revoke_rules_on_delete", "rule_management", "ingress", "egress"},
 			},
 		},
 	})
@@ -99,7 +176,7 @@ func TestAccAWSSecurityGroup_importSourceSecurityGroup(t *testing.T) {
 				ResourceName:            "aws_security_group.test_group_1",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"revoke_rules_on_delete"},
+				ImportStateVerifyIgnore: []string{"revoke_rules_on_delete", "rule_management", "ingress", "egress"},
 			},
 		},
 	})
@@ -188,3 +265,14 @@ func TestAccAWSSecurityGroup_importPrefixList(t *testing.T) {
 		},
 	})
 }
+
+func testAccAWSSecurityGroupImportStateIdFunc(n, ruleManagement string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", n)
+		}
+
+		return fmt.Sprintf("%s/%s", rs.Primary.ID, ruleManagement), nil
+	}
+}
@@ -0,0 +1,156 @@
+package aws
+
+import (
+	"archive/zip"
+	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/mitchellh/go-homedir"
+)
+
+// lambdaDirectUploadLimit is the largest deployment package which can be
+// sent inline with CreateFunction / UpdateFunctionCode. Larger packages have
+// to be uploaded to S3 first.
+const lambdaDirectUploadLimit = 50 * 1024 * 1024
+
+// lambdaPackageModTime is used for every entry in a package built from
+// source_dir, so that the archive only depends on file names and contents.
+var lambdaPackageModTime = time.Date(1980, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+// buildLambdaPackage zips the contents of dir into a deployment package.
+// Entries are sorted by path and carry a fixed timestamp and normalised
+// permissions, so the same tree always produces byte-identical output.
+// Paths relative to dir which match one of the exclude globs are skipped; a
+// pattern matching a directory skips everything below it.
+func buildLambdaPackage(dir string, excludes []string) ([]byte, error) {
+	root, err := homedir.Expand(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	info, err := os.Stat(root)
+	if err != nil {
+		return nil, err
+	}
+	if !info.IsDir() {
+		return nil, fmt.Errorf("%q is not a directory", dir)
+	}
+
+	for _, pattern := range excludes {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return nil, fmt.Errorf("invalid exclude pattern %q: %s", pattern, err)
+		}
+	}
+
+	var files []string
+	err = filepath.Walk(root, func(p string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if p == root {
+			return nil
+		}
+
+		rel, err := filepath.Rel(root, p)
+		if err != nil {
+			return err
+		}
+		rel = filepath.ToSlash(rel)
+
+		if lambdaPackageExcluded(rel, excludes) {
+			if info.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+
+		if info.Mode().IsRegular() || info.Mode()&os.ModeSymlink != 0 {
+			files = append(files, rel)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Strings(files)
+
+	buf := new(bytes.Buffer)
+	w := zip.NewWriter(buf)
+	for _, name := range files {
+		p := filepath.Join(root, filepath.FromSlash(name))
+
+		// Stat follows symlinks, so linked files are packaged by content.
+		info, err := os.Stat(p)
+		if err != nil {
+			return nil, err
+		}
+		if info.IsDir() {
+			continue
+		}
+
+		content, err := ioutil.ReadFile(p)
+		if err != nil {
+			return nil, err
+		}
+
+		header := &zip.FileHeader{
+			Name:   name,
+			Method: zip.Deflate,
+		}
+		header.SetModTime(lambdaPackageModTime)
+		// Only the executable bit matters to Lambda; dropping the rest keeps
+		// packages identical regardless of the umask they were checked out
+		// with.
+		if info.Mode()&0111 != 0 {
+			header.SetMode(0755)
+		} else {
+			header.SetMode(0644)
+		}
+
+		f, err := w.CreateHeader(header)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := f.Write(content); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+func lambdaPackageExcluded(name string, excludes []string) bool {
+	for _, pattern := range excludes {
+		if matched, _ := path.Match(pattern, name); matched {
+			return true
+		}
+	}
+	return false
+}
+
+// lambdaPackageHash returns the hash of a deployment package in the same
+// format as the CodeSha256 reported by Lambda.
+func lambdaPackageHash(pkg []byte) string {
+	sum := sha256.Sum256(pkg)
+	return base64.StdEncoding.EncodeToString(sum[:])
+}
+
+// lambdaPackageS3Key returns the default S3 key for a package built from
+// source_dir. Keys are content-addressed so that re-uploading the same
+// package is harmless.
+func lambdaPackageS3Key(functionName string, pkg []byte) string {
+	return fmt.Sprintf("%s/%x.zip", functionName, sha256.Sum256(pkg))
+}
@@ -0,0 +1,120 @@
+package aws
+
+import (
+	"archive/zip"
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestBuildLambdaPackage(t *testing.T) {
+	dir, err := ioutil.TempDir("", "tf-lambda-package")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	files := map[string]os.FileMode{
+		"index.js":             0644,
+		"bin/run":              0700,
+		"lib/util.js":          0600,
+		"node_modules/a/a.js":  0644,
+		"test/index_test.js":   0644,
+		"lib/util_test.js":     0644,
+		"README.md":            0644,
+		".git/HEAD":            0644,
+		"lib/nested/deep/x.js": 0644,
+	}
+	for name, mode := range files {
+		p := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(p), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(p, []byte(name), mode); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	excludes := []string{".git", "test", "*.md", "lib/*_test.js"}
+
+	pkg, err := buildLambdaPackage(dir, excludes)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r, err := zip.NewReader(bytes.NewReader(pkg), int64(len(pkg)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var names []string
+	modes := make(map[string]os.FileMode)
+	for _, f := range r.File {
+		names = append(names, f.Name)
+		modes[f.Name] = f.Mode()
+		if !f.ModTime().Equal(lambdaPackageModTime) {
+			t.Errorf("%s: expected mod time %s, got %s", f.Name, lambdaPackageModTime, f.ModTime())
+		}
+	}
+
+	expected := []string{
+		"bin/run",
+		"index.js",
+		"lib/nested/deep/x.js",
+		"lib/util.js",
+		"node_modules/a/a.js",
+	}
+	if !reflect.DeepEqual(names, expected) {
+		t.Fatalf("expected entries %v, got %v", expected, names)
+	}
+	if modes["bin/run"] != 0755 {
+		t.Errorf("bin/run: expected mode 0755, got %s", modes["bin/run"])
+	}
+	if modes["lib/util.js"] != 0644 {
+		t.Errorf("lib/util.js: expected mode 0644, got %s", modes["lib/util.js"])
+	}
+
+	// Touching files must not change the package.
+	later := lambdaPackageModTime.AddDate(30, 0, 0)
+	if err := os.Chtimes(filepath.Join(dir, "index.js"), later, later); err != nil {
+		t.Fatal(err)
+	}
+	again, err := buildLambdaPackage(dir, excludes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if lambdaPackageHash(again) != lambdaPackageHash(pkg) {
+		t.Fatalf("expected identical packages, got hashes %s and %s", lambdaPackageHash(pkg), lambdaPackageHash(again))
+	}
+
+	if err := ioutil.WriteFile(filepath.Join(dir, "index.js"), []byte("changed"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	changed, err := buildLambdaPackage(dir, excludes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if lambdaPackageHash(changed) == lambdaPackageHash(pkg) {
+		t.Fatal("expected package hash to change with file contents")
+	}
+}
+
+func TestBuildLambdaPackage_invalid(t *testing.T) {
+	f, err := ioutil.TempFile("", "tf-lambda-package")
+	if err != nil {
+		t.Fatal(err)
+	}
+	f.Close()
+	defer os.Remove(f.Name())
+
+	if _, err := buildLambdaPackage(f.Name(), nil); err == nil {
+		t.Fatal("expected an error packaging a file")
+	}
+
+	if _, err := buildLambdaPackage(filepath.Dir(f.Name()), []string{"["}); err == nil {
+		t.Fatal("expected an error for a malformed exclude pattern")
+	}
+}
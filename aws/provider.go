@@ -163,6 +163,7 @@ func Provider() terraform.ResourceProvider {
 			"aws_acm_certificate":                  dataSourceAwsAcmCertificate(),
 			"aws_ami":                              dataSourceAwsAmi(),
 			"aws_ami_ids":                          dataSourceAwsAmiIds(),
+			"aws_autoscaling_group":                dataSourceAwsAutoscalingGroup(),
 			"aws_autoscaling_groups":               dataSourceAwsAutoscalingGroups(),
 			"aws_availability_zone":                dataSourceAwsAvailabilityZone(),
 			"aws_availability_zones":               dataSourceAwsAvailabilityZones(),
@@ -174,9 +175,11 @@ func Provider() terraform.ResourceProvider {
 			"aws_db_instance":                      dataSourceAwsDbInstance(),
 			"aws_db_snapshot":                      dataSourceAwsDbSnapshot(),
 			"aws_dynamodb_table":                   dataSourceAwsDynamoDbTable(),
+			"aws_dynamodb_table_backup":            dataSourceAwsDynamoDbTableBackup(),
 			"aws_ebs_snapshot":                     dataSourceAwsEbsSnapshot(),
 			"aws_ebs_snapshot_ids":                 dataSourceAwsEbsSnapshotIds(),
 			"aws_ebs_volume":                       dataSourceAwsEbsVolume(),
+			"aws_ec2_reserved_instances_offerings": dataSourceAwsEc2ReservedInstancesOfferings(),
 			"aws_ecr_repository":                   dataSourceAwsEcrRepository(),
 			"aws_ecs_cluster":                      dataSourceAwsEcsCluster(),
 			"aws_ecs_container_definition":         dataSourceAwsEcsContainerDefinition(),
@@ -209,23 +212,30 @@ func Provider() terraform.ResourceProvider {
 			"aws_kms_ciphertext":                   dataSourceAwsKmsCiphertext(),
 			"aws_kms_key":                          dataSourceAwsKmsKey(),
 			"aws_kms_secret":                       dataSourceAwsKmsSecret(),
+			"aws_lambda_function":                  dataSourceAwsLambdaFunction(),
+			"aws_lambda_invocation":                dataSourceAwsLambdaInvocation(),
 			"aws_nat_gateway":                      dataSourceAwsNatGateway(),
 			"aws_network_interface":                dataSourceAwsNetworkInterface(),
+			"aws_network_interfaces":               dataSourceAwsNetworkInterfaces(),
 			"aws_partition":                        dataSourceAwsPartition(),
 			"aws_prefix_list":                      dataSourceAwsPrefixList(),
 			"aws_rds_cluster":                      dataSourceAwsRdsCluster(),
 			"aws_redshift_service_account":         dataSourceAwsRedshiftServiceAccount(),
 			"aws_region":                           dataSourceAwsRegion(),
 			"aws_route_table":                      dataSourceAwsRouteTable(),
+			"aws_route_tables":                     dataSourceAwsRouteTables(),
 			"aws_route53_zone":                     dataSourceAwsRoute53Zone(),
 			"aws_s3_bucket":                        dataSourceAwsS3Bucket(),
 			"aws_s3_bucket_object":                 dataSourceAwsS3BucketObject(),
+			"aws_s3_bucket_objects":                dataSourceAwsS3BucketObjects(),
 			"aws_sns_topic":                        dataSourceAwsSnsTopic(),
 			"aws_ssm_parameter":                    dataSourceAwsSsmParameter(),
 			"aws_subnet":                           dataSourceAwsSubnet(),
 			"aws_subnet_ids":                       dataSourceAwsSubnetIDs(),
 			"aws_security_group":                   dataSourceAwsSecurityGroup(),
+			"aws_security_groups":                  dataSourceAwsSecurityGroups(),
 			"aws_vpc":                              dataSourceAwsVpc(),
+			"aws_vpcs":                             dataSourceAwsVpcs(),
 			"aws_vpc_endpoint":                     dataSourceAwsVpcEndpoint(),
 			"aws_vpc_endpoint_service":             dataSourceAwsVpcEndpointService(),
 			"aws_vpc_peering_connection":           dataSourceAwsVpcPeeringConnection(),
@@ -241,322 +251,338 @@ func Provider() terraform.ResourceProvider {
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
-			"aws_acm_certificate":                          resourceAwsAcmCertificate(),
-			"aws_acm_certificate_validation":               resourceAwsAcmCertificateValidation(),
-			"aws_ami":                                      resourceAwsAmi(),
-			"aws_ami_copy":                                 resourceAwsAmiCopy(),
-			"aws_ami_from_instance":                        resourceAwsAmiFromInstance(),
-			"aws_ami_launch_permission":                    resourceAwsAmiLaunchPermission(),
-			"aws_api_gateway_account":                      resourceAwsApiGatewayAccount(),
-			"aws_api_gateway_api_key":                      resourceAwsApiGatewayApiKey(),
-			"aws_api_gateway_authorizer":                   resourceAwsApiGatewayAuthorizer(),
-			"aws_api_gateway_base_path_mapping":            resourceAwsApiGatewayBasePathMapping(),
-			"aws_api_gateway_client_certificate":           resourceAwsApiGatewayClientCertificate(),
-			"aws_api_gateway_deployment":                   resourceAwsApiGatewayDeployment(),
-			"aws_api_gateway_documentation_part":           resourceAwsApiGatewayDocumentationPart(),
-			"aws_api_gateway_documentation_version":        resourceAwsApiGatewayDocumentationVersion(),
-			"aws_api_gateway_domain_name":                  resourceAwsApiGatewayDomainName(),
-			"aws_api_gateway_gateway_response":             resourceAwsApiGatewayGatewayResponse(),
-			"aws_api_gateway_integration":                  resourceAwsApiGatewayIntegration(),
-			"aws_api_gateway_integration_response":         resourceAwsApiGatewayIntegrationResponse(),
-			"aws_api_gateway_method":                       resourceAwsApiGatewayMethod(),
-			"aws_api_gateway_method_response":              resourceAwsApiGatewayMethodResponse(),
-			"aws_api_gateway_method_settings":              resourceAwsApiGatewayMethodSettings(),
-			"aws_api_gateway_model":                        resourceAwsApiGatewayModel(),
-			"aws_api_gateway_request_validator":            resourceAwsApiGatewayRequestValidator(),
-			"aws_api_gateway_resource":                     resourceAwsApiGatewayResource(),
-			"aws_api_gateway_rest_api":                     resourceAwsApiGatewayRestApi(),
-			"aws_api_gateway_stage":                        resourceAwsApiGatewayStage(),
-			"aws_api_gateway_usage_plan":                   resourceAwsApiGatewayUsagePlan(),
-			"aws_api_gateway_usage_plan_key":               resourceAwsApiGatewayUsagePlanKey(),
-			"aws_api_gateway_vpc_link":                     resourceAwsApiGatewayVpcLink(),
-			"aws_app_cookie_stickiness_policy":             resourceAwsAppCookieStickinessPolicy(),
-			"aws_appautoscaling_target":                    resourceAwsAppautoscalingTarget(),
-			"aws_appautoscaling_policy":                    resourceAwsAppautoscalingPolicy(),
-			"aws_appautoscaling_scheduled_action":          resourceAwsAppautoscalingScheduledAction(),
-			"aws_appsync_graphql_api":                      resourceAwsAppsyncGraphqlApi(),
-			"aws_athena_database":                          resourceAwsAthenaDatabase(),
-			"aws_athena_named_query":                       resourceAwsAthenaNamedQuery(),
-			"aws_autoscaling_attachment":                   resourceAwsAutoscalingAttachment(),
-			"aws_autoscaling_group":                        resourceAwsAutoscalingGroup(),
-			"aws_autoscaling_lifecycle_hook":               resourceAwsAutoscalingLifecycleHook(),
-			"aws_autoscaling_notification":                 resourceAwsAutoscalingNotification(),
-			"aws_autoscaling_policy":                       resourceAwsAutoscalingPolicy(),
-			"aws_autoscaling_schedule":                     resourceAwsAutoscalingSchedule(),
-			"aws_cloud9_environment_ec2":                   resourceAwsCloud9EnvironmentEc2(),
-			"aws_cloudformation_stack":                     resourceAwsCloudFormationStack(),
-			"aws_cloudfront_distribution":                  resourceAwsCloudFrontDistribution(),
-			"aws_cloudfront_origin_access_identity":        resourceAwsCloudFrontOriginAccessIdentity(),
-			"aws_cloudtrail":                               resourceAwsCloudTrail(),
-			"aws_cloudwatch_event_permission":              resourceAwsCloudWatchEventPermission(),
-			"aws_cloudwatch_event_rule":                    resourceAwsCloudWatchEventRule(),
-			"aws_cloudwatch_event_target":                  resourceAwsCloudWatchEventTarget(),
-			"aws_cloudwatch_log_destination":               resourceAwsCloudWatchLogDestination(),
-			"aws_cloudwatch_log_destination_policy":        resourceAwsCloudWatchLogDestinationPolicy(),
-			"aws_cloudwatch_log_group":                     resourceAwsCloudWatchLogGroup(),
-			"aws_cloudwatch_log_metric_filter":             resourceAwsCloudWatchLogMetricFilter(),
-			"aws_cloudwatch_log_resource_policy":           resourceAwsCloudWatchLogResourcePolicy(),
-			"aws_cloudwatch_log_stream":                    resourceAwsCloudWatchLogStream(),
-			"aws_cloudwatch_log_subscription_filter":       resourceAwsCloudwatchLogSubscriptionFilter(),
-			"aws_config_config_rule":                       resourceAwsConfigConfigRule(),
-			"aws_config_configuration_recorder":            resourceAwsConfigConfigurationRecorder(),
-			"aws_config_configuration_recorder_status":     resourceAwsConfigConfigurationRecorderStatus(),
-			"aws_config_delivery_channel":                  resourceAwsConfigDeliveryChannel(),
-			"aws_cognito_identity_pool":                    resourceAwsCognitoIdentityPool(),
-			"aws_cognito_identity_pool_roles_attachment":   resourceAwsCognitoIdentityPoolRolesAttachment(),
-			"aws_cognito_user_group":                       resourceAwsCognitoUserGroup(),
-			"aws_cognito_user_pool":                        resourceAwsCognitoUserPool(),
-			"aws_cognito_user_pool_client":                 resourceAwsCognitoUserPoolClient(),
-			"aws_cognito_user_pool_domain":                 resourceAwsCognitoUserPoolDomain(),
-			"aws_cloudwatch_metric_alarm":                  resourceAwsCloudWatchMetricAlarm(),
-			"aws_cloudwatch_dashboard":                     resourceAwsCloudWatchDashboard(),
-			"aws_codedeploy_app":                           resourceAwsCodeDeployApp(),
-			"aws_codedeploy_deployment_config":             resourceAwsCodeDeployDeploymentConfig(),
-			"aws_codedeploy_deployment_group":              resourceAwsCodeDeployDeploymentGroup(),
-			"aws_codecommit_repository":                    resourceAwsCodeCommitRepository(),
-			"aws_codecommit_trigger":                       resourceAwsCodeCommitTrigger(),
-			"aws_codebuild_project":                        resourceAwsCodeBuildProject(),
-			"aws_codepipeline":                             resourceAwsCodePipeline(),
-			"aws_customer_gateway":                         resourceAwsCustomerGateway(),
-			"aws_dax_cluster":                              resourceAwsDaxCluster(),
-			"aws_db_event_subscription":                    resourceAwsDbEventSubscription(),
-			"aws_db_instance":                              resourceAwsDbInstance(),
-			"aws_db_option_group":                          resourceAwsDbOptionGroup(),
-			"aws_db_parameter_group":                       resourceAwsDbParameterGroup(),
-			"aws_db_security_group":                        resourceAwsDbSecurityGroup(),
-			"aws_db_snapshot":                              resourceAwsDbSnapshot(),
-			"aws_db_subnet_group":                          resourceAwsDbSubnetGroup(),
-			"aws_devicefarm_project":                       resourceAwsDevicefarmProject(),
-			"aws_directory_service_directory":              resourceAwsDirectoryServiceDirectory(),
-			"aws_dms_certificate":                          resourceAwsDmsCertificate(),
-			"aws_dms_endpoint":                             resourceAwsDmsEndpoint(),
-			"aws_dms_replication_instance":                 resourceAwsDmsReplicationInstance(),
-			"aws_dms_replication_subnet_group":             resourceAwsDmsReplicationSubnetGroup(),
-			"aws_dms_replication_task":                     resourceAwsDmsReplicationTask(),
-			"aws_dx_lag":                                   resourceAwsDxLag(),
-			"aws_dx_connection":                            resourceAwsDxConnection(),
-			"aws_dx_connection_association":                resourceAwsDxConnectionAssociation(),
-			"aws_dynamodb_table":                           resourceAwsDynamoDbTable(),
-			"aws_dynamodb_table_item":                      resourceAwsDynamoDbTableItem(),
-			"aws_dynamodb_global_table":                    resourceAwsDynamoDbGlobalTable(),
-			"aws_ebs_snapshot":                             resourceAwsEbsSnapshot(),
-			"aws_ebs_volume":                               resourceAwsEbsVolume(),
-			"aws_ecr_lifecycle_policy":                     resourceAwsEcrLifecyclePolicy(),
-			"aws_ecr_repository":                           resourceAwsEcrRepository(),
-			"aws_ecr_repository_policy":                    resourceAwsEcrRepositoryPolicy(),
-			"aws_ecs_cluster":                              resourceAwsEcsCluster(),
-			"aws_ecs_service":                              resourceAwsEcsService(),
-			"aws_ecs_task_definition":                      resourceAwsEcsTaskDefinition(),
-			"aws_efs_file_system":                          resourceAwsEfsFileSystem(),
-			"aws_efs_mount_target":                         resourceAwsEfsMountTarget(),
-			"aws_egress_only_internet_gateway":             resourceAwsEgressOnlyInternetGateway(),
-			"aws_eip":                                      resourceAwsEip(),
-			"aws_eip_association":                          resourceAwsEipAssociation(),
-			"aws_elasticache_cluster":                      resourceAwsElasticacheCluster(),
-			"aws_elasticache_parameter_group":              resourceAwsElasticacheParameterGroup(),
-			"aws_elasticache_replication_group":            resourceAwsElasticacheReplicationGroup(),
-			"aws_elasticache_security_group":               resourceAwsElasticacheSecurityGroup(),
-			"aws_elasticache_subnet_group":                 resourceAwsElasticacheSubnetGroup(),
-			"aws_elastic_beanstalk_application":            resourceAwsElasticBeanstalkApplication(),
-			"aws_elastic_beanstalk_application_version":    resourceAwsElasticBeanstalkApplicationVersion(),
-			"aws_elastic_beanstalk_configuration_template": resourceAwsElasticBeanstalkConfigurationTemplate(),
-			"aws_elastic_beanstalk_environment":            resourceAwsElasticBeanstalkEnvironment(),
-			"aws_elasticsearch_domain":                     resourceAwsElasticSearchDomain(),
-			"aws_elasticsearch_domain_policy":              resourceAwsElasticSearchDomainPolicy(),
-			"aws_elastictranscoder_pipeline":               resourceAwsElasticTranscoderPipeline(),
-			"aws_elastictranscoder_preset":                 resourceAwsElasticTranscoderPreset(),
-			"aws_elb":                                      resourceAwsElb(),
-			"aws_elb_attachment":                           resourceAwsElbAttachment(),
-			"aws_emr_cluster":                              resourceAwsEMRCluster(),
-			"aws_emr_instance_group":                       resourceAwsEMRInstanceGroup(),
-			"aws_emr_security_configuration":               resourceAwsEMRSecurityConfiguration(),
-			"aws_flow_log":                                 resourceAwsFlowLog(),
-			"aws_gamelift_alias":                           resourceAwsGameliftAlias(),
-			"aws_gamelift_build":                           resourceAwsGameliftBuild(),
-			"aws_gamelift_fleet":                           resourceAwsGameliftFleet(),
-			"aws_glacier_vault":                            resourceAwsGlacierVault(),
-			"aws_glue_catalog_database":                    resourceAwsGlueCatalogDatabase(),
-			"aws_guardduty_detector":                       resourceAwsGuardDutyDetector(),
-			"aws_guardduty_ipset":                          resourceAwsGuardDutyIpset(),
-			"aws_guardduty_member":                         resourceAwsGuardDutyMember(),
-			"aws_guardduty_threatintelset":                 resourceAwsGuardDutyThreatintelset(),
-			"aws_iam_access_key":                           resourceAwsIamAccessKey(),
-			"aws_iam_account_alias":                        resourceAwsIamAccountAlias(),
-			"aws_iam_account_password_policy":              resourceAwsIamAccountPasswordPolicy(),
-			"aws_iam_group_policy":                         resourceAwsIamGroupPolicy(),
-			"aws_iam_group":                                resourceAwsIamGroup(),
-			"aws_iam_group_membership":                     resourceAwsIamGroupMembership(),
-			"aws_iam_group_policy_attachment":              resourceAwsIamGroupPolicyAttachment(),
-			"aws_iam_instance_profile":                     resourceAwsIamInstanceProfile(),
-			"aws_iam_openid_connect_provider":              resourceAwsIamOpenIDConnectProvider(),
-			"aws_iam_policy":                               resourceAwsIamPolicy(),
-			"aws_iam_policy_attachment":                    resourceAwsIamPolicyAttachment(),
-			"aws_iam_role_policy_attachment":               resourceAwsIamRolePolicyAttachment(),
-			"aws_iam_role_policy":                          resourceAwsIamRolePolicy(),
-			"aws_iam_role":                                 resourceAwsIamRole(),
-			"aws_iam_saml_provider":                        resourceAwsIamSamlProvider(),
-			"aws_iam_server_certificate":                   resourceAwsIAMServerCertificate(),
-			"aws_iam_user_policy_attachment":               resourceAwsIamUserPolicyAttachment(),
-			"aws_iam_user_policy":                          resourceAwsIamUserPolicy(),
-			"aws_iam_user_ssh_key":                         resourceAwsIamUserSshKey(),
-			"aws_iam_user":                                 resourceAwsIamUser(),
-			"aws_iam_user_login_profile":                   resourceAwsIamUserLoginProfile(),
-			"aws_inspector_assessment_target":              resourceAWSInspectorAssessmentTarget(),
-			"aws_inspector_assessment_template":            resourceAWSInspectorAssessmentTemplate(),
-			"aws_inspector_resource_group":                 resourceAWSInspectorResourceGroup(),
-			"aws_instance":                                 resourceAwsInstance(),
-			"aws_internet_gateway":                         resourceAwsInternetGateway(),
-			"aws_iot_certificate":                          resourceAwsIotCertificate(),
-			"aws_iot_policy":                               resourceAwsIotPolicy(),
-			"aws_iot_thing":                                resourceAwsIotThing(),
-			"aws_iot_thing_type":                           resourceAwsIotThingType(),
-			"aws_iot_topic_rule":                           resourceAwsIotTopicRule(),
-			"aws_key_pair":                                 resourceAwsKeyPair(),
-			"aws_kinesis_firehose_delivery_stream":         resourceAwsKinesisFirehoseDeliveryStream(),
-			"aws_kinesis_stream":                           resourceAwsKinesisStream(),
-			"aws_kms_alias":                                resourceAwsKmsAlias(),
-			"aws_kms_key":                                  resourceAwsKmsKey(),
-			"aws_lambda_function":                          resourceAwsLambdaFunction(),
-			"aws_lambda_event_source_mapping":              resourceAwsLambdaEventSourceMapping(),
-			"aws_lambda_alias":                             resourceAwsLambdaAlias(),
-			"aws_lambda_permission":                        resourceAwsLambdaPermission(),
-			"aws_launch_configuration":                     resourceAwsLaunchConfiguration(),
-			"aws_lightsail_domain":                         resourceAwsLightsailDomain(),
-			"aws_lightsail_instance":                       resourceAwsLightsailInstance(),
-			"aws_lightsail_key_pair":                       resourceAwsLightsailKeyPair(),
-			"aws_lightsail_static_ip":                      resourceAwsLightsailStaticIp(),
-			"aws_lightsail_static_ip_attachment":           resourceAwsLightsailStaticIpAttachment(),
-			"aws_lb_cookie_stickiness_policy":              resourceAwsLBCookieStickinessPolicy(),
-			"aws_load_balancer_policy":                     resourceAwsLoadBalancerPolicy(),
-			"aws_load_balancer_backend_server_policy":      resourceAwsLoadBalancerBackendServerPolicies(),
-			"aws_load_balancer_listener_policy":            resourceAwsLoadBalancerListenerPolicies(),
-			"aws_lb_ssl_negotiation_policy":                resourceAwsLBSSLNegotiationPolicy(),
-			"aws_main_route_table_association":             resourceAwsMainRouteTableAssociation(),
-			"aws_mq_broker":                                resourceAwsMqBroker(),
-			"aws_mq_configuration":                         resourceAwsMqConfiguration(),
-			"aws_media_store_container":                    resourceAwsMediaStoreContainer(),
-			"aws_nat_gateway":                              resourceAwsNatGateway(),
-			"aws_network_acl":                              resourceAwsNetworkAcl(),
-			"aws_default_network_acl":                      resourceAwsDefaultNetworkAcl(),
-			"aws_network_acl_rule":                         resourceAwsNetworkAclRule(),
-			"aws_network_interface":                        resourceAwsNetworkInterface(),
-			"aws_network_interface_attachment":             resourceAwsNetworkInterfaceAttachment(),
-			"aws_opsworks_application":                     resourceAwsOpsworksApplication(),
-			"aws_opsworks_stack":                           resourceAwsOpsworksStack(),
-			"aws_opsworks_java_app_layer":                  resourceAwsOpsworksJavaAppLayer(),
-			"aws_opsworks_haproxy_layer":                   resourceAwsOpsworksHaproxyLayer(),
-			"aws_opsworks_static_web_layer":                resourceAwsOpsworksStaticWebLayer(),
-			"aws_opsworks_php_app_layer":                   resourceAwsOpsworksPhpAppLayer(),
-			"aws_opsworks_rails_app_layer":                 resourceAwsOpsworksRailsAppLayer(),
-			"aws_opsworks_nodejs_app_layer":                resourceAwsOpsworksNodejsAppLayer(),
-			"aws_opsworks_memcached_layer":                 resourceAwsOpsworksMemcachedLayer(),
-			"aws_opsworks_mysql_layer":                     resourceAwsOpsworksMysqlLayer(),
-			"aws_opsworks_ganglia_layer":                   resourceAwsOpsworksGangliaLayer(),
-			"aws_opsworks_custom_layer":                    resourceAwsOpsworksCustomLayer(),
-			"aws_opsworks_instance":                        resourceAwsOpsworksInstance(),
-			"aws_opsworks_user_profile":                    resourceAwsOpsworksUserProfile(),
-			"aws_opsworks_permission":                      resourceAwsOpsworksPermission(),
-			"aws_opsworks_rds_db_instance":                 resourceAwsOpsworksRdsDbInstance(),
-			"aws_organizations_organization":               resourceAwsOrganizationsOrganization(),
-			"aws_placement_group":                          resourceAwsPlacementGroup(),
-			"aws_proxy_protocol_policy":                    resourceAwsProxyProtocolPolicy(),
-			"aws_rds_cluster":                              resourceAwsRDSCluster(),
-			"aws_rds_cluster_instance":                     resourceAwsRDSClusterInstance(),
-			"aws_rds_cluster_parameter_group":              resourceAwsRDSClusterParameterGroup(),
-			"aws_redshift_cluster":                         resourceAwsRedshiftCluster(),
-			"aws_redshift_security_group":                  resourceAwsRedshiftSecurityGroup(),
-			"aws_redshift_parameter_group":                 resourceAwsRedshiftParameterGroup(),
-			"aws_redshift_subnet_group":                    resourceAwsRedshiftSubnetGroup(),
-			"aws_route53_delegation_set":                   resourceAwsRoute53DelegationSet(),
-			"aws_route53_query_log":                        resourceAwsRoute53QueryLog(),
-			"aws_route53_record":                           resourceAwsRoute53Record(),
-			"aws_route53_zone_association":                 resourceAwsRoute53ZoneAssociation(),
-			"aws_route53_zone":                             resourceAwsRoute53Zone(),
-			"aws_route53_health_check":                     resourceAwsRoute53HealthCheck(),
-			"aws_route":                                    resourceAwsRoute(),
-			"aws_route_table":                              resourceAwsRouteTable(),
-			"aws_default_route_table":                      resourceAwsDefaultRouteTable(),
-			"aws_route_table_association":                  resourceAwsRouteTableAssociation(),
-			"aws_ses_active_receipt_rule_set":              resourceAwsSesActiveReceiptRuleSet(),
-			"aws_ses_domain_identity":                      resourceAwsSesDomainIdentity(),
-			"aws_ses_domain_dkim":                          resourceAwsSesDomainDkim(),
-			"aws_ses_domain_mail_from":                     resourceAwsSesDomainMailFrom(),
-			"aws_ses_receipt_filter":                       resourceAwsSesReceiptFilter(),
-			"aws_ses_receipt_rule":                         resourceAwsSesReceiptRule(),
-			"aws_ses_receipt_rule_set":                     resourceAwsSesReceiptRuleSet(),
-			"aws_ses_configuration_set":                    resourceAwsSesConfigurationSet(),
-			"aws_ses_event_destination":                    resourceAwsSesEventDestination(),
-			"aws_ses_template":                             resourceAwsSesTemplate(),
-			"aws_s3_bucket":                                resourceAwsS3Bucket(),
-			"aws_s3_bucket_policy":                         resourceAwsS3BucketPolicy(),
-			"aws_s3_bucket_object":                         resourceAwsS3BucketObject(),
-			"aws_s3_bucket_notification":                   resourceAwsS3BucketNotification(),
-			"aws_s3_bucket_metric":                         resourceAwsS3BucketMetric(),
-			"aws_security_group":                           resourceAwsSecurityGroup(),
-			"aws_network_interface_sg_attachment":          resourceAwsNetworkInterfaceSGAttachment(),
-			"aws_default_security_group":                   resourceAwsDefaultSecurityGroup(),
-			"aws_security_group_rule":                      resourceAwsSecurityGroupRule(),
-			"aws_servicecatalog_portfolio":                 resourceAwsServiceCatalogPortfolio(),
-			"aws_service_discovery_private_dns_namespace":  resourceAwsServiceDiscoveryPrivateDnsNamespace(),
-			"aws_service_discovery_public_dns_namespace":   resourceAwsServiceDiscoveryPublicDnsNamespace(),
-			"aws_service_discovery_service":                resourceAwsServiceDiscoveryService(),
-			"aws_simpledb_domain":                          resourceAwsSimpleDBDomain(),
-			"aws_ssm_activation":                           resourceAwsSsmActivation(),
-			"aws_ssm_association":                          resourceAwsSsmAssociation(),
-			"aws_ssm_document":                             resourceAwsSsmDocument(),
-			"aws_ssm_maintenance_window":                   resourceAwsSsmMaintenanceWindow(),
-			"aws_ssm_maintenance_window_target":            resourceAwsSsmMaintenanceWindowTarget(),
-			"aws_ssm_maintenance_window_task":              resourceAwsSsmMaintenanceWindowTask(),
-			"aws_ssm_patch_baseline":                       resourceAwsSsmPatchBaseline(),
-			"aws_ssm_patch_group":                          resourceAwsSsmPatchGroup(),
-			"aws_ssm_parameter":                            resourceAwsSsmParameter(),
-			"aws_ssm_resource_data_sync":                   resourceAwsSsmResourceDataSync(),
-			"aws_spot_datafeed_subscription":               resourceAwsSpotDataFeedSubscription(),
-			"aws_spot_instance_request":                    resourceAwsSpotInstanceRequest(),
-			"aws_spot_fleet_request":                       resourceAwsSpotFleetRequest(),
-			"aws_sqs_queue":                                resourceAwsSqsQueue(),
-			"aws_sqs_queue_policy":                         resourceAwsSqsQueuePolicy(),
-			"aws_snapshot_create_volume_permission":        resourceAwsSnapshotCreateVolumePermission(),
-			"aws_sns_platform_application":                 resourceAwsSnsPlatformApplication(),
-			"aws_sns_topic":                                resourceAwsSnsTopic(),
-			"aws_sns_topic_policy":                         resourceAwsSnsTopicPolicy(),
-			"aws_sns_topic_subscription":                   resourceAwsSnsTopicSubscription(),
-			"aws_sfn_activity":                             resourceAwsSfnActivity(),
-			"aws_sfn_state_machine":                        resourceAwsSfnStateMachine(),
-			"aws_default_subnet":                           resourceAwsDefaultSubnet(),
-			"aws_subnet":                                   resourceAwsSubnet(),
-			"aws_volume_attachment":                        resourceAwsVolumeAttachment(),
-			"aws_vpc_dhcp_options_association":             resourceAwsVpcDhcpOptionsAssociation(),
-			"aws_default_vpc_dhcp_options":                 resourceAwsDefaultVpcDhcpOptions(),
-			"aws_vpc_dhcp_options":                         resourceAwsVpcDhcpOptions(),
-			"aws_vpc_peering_connection":                   resourceAwsVpcPeeringConnection(),
-			"aws_vpc_peering_connection_accepter":          resourceAwsVpcPeeringConnectionAccepter(),
-			"aws_default_vpc":                              resourceAwsDefaultVpc(),
-			"aws_vpc":                                      resourceAwsVpc(),
-			"aws_vpc_endpoint":                             resourceAwsVpcEndpoint(),
-			"aws_vpc_endpoint_connection_notification":     resourceAwsVpcEndpointConnectionNotification(),
-			"aws_vpc_endpoint_route_table_association":     resourceAwsVpcEndpointRouteTableAssociation(),
-			"aws_vpc_endpoint_subnet_association":          resourceAwsVpcEndpointSubnetAssociation(),
-			"aws_vpc_endpoint_service":                     resourceAwsVpcEndpointService(),
-			"aws_vpc_endpoint_service_allowed_principal":   resourceAwsVpcEndpointServiceAllowedPrincipal(),
-			"aws_vpn_connection":                           resourceAwsVpnConnection(),
-			"aws_vpn_connection_route":                     resourceAwsVpnConnectionRoute(),
-			"aws_vpn_gateway":                              resourceAwsVpnGateway(),
-			"aws_vpn_gateway_attachment":                   resourceAwsVpnGatewayAttachment(),
-			"aws_vpn_gateway_route_propagation":            resourceAwsVpnGatewayRoutePropagation(),
-			"aws_waf_byte_match_set":                       resourceAwsWafByteMatchSet(),
-			"aws_waf_ipset":                                resourceAwsWafIPSet(),
-			"aws_waf_rule":                                 resourceAwsWafRule(),
-			"aws_waf_rate_based_rule":                      resourceAwsWafRateBasedRule(),
-			"aws_waf_size_constraint_set":                  resourceAwsWafSizeConstraintSet(),
-			"aws_waf_web_acl":                              resourceAwsWafWebAcl(),
-			"aws_waf_xss_match_set":                        resourceAwsWafXssMatchSet(),
-			"aws_waf_sql_injection_match_set":              resourceAwsWafSqlInjectionMatchSet(),
-			"aws_wafregional_byte_match_set":               resourceAwsWafRegionalByteMatchSet(),
-			"aws_wafregional_ipset":                        resourceAwsWafRegionalIPSet(),
-			"aws_batch_compute_environment":                resourceAwsBatchComputeEnvironment(),
-			"aws_batch_job_definition":                     resourceAwsBatchJobDefinition(),
-			"aws_batch_job_queue":                          resourceAwsBatchJobQueue(),
+			"aws_acm_certificate":                                resourceAwsAcmCertificate(),
+			"aws_acm_certificate_validation":                     resourceAwsAcmCertificateValidation(),
+			"aws_ami":                                            resourceAwsAmi(),
+			"aws_ami_copy":                                       resourceAwsAmiCopy(),
+			"aws_ami_from_instance":                              resourceAwsAmiFromInstance(),
+			"aws_ami_import":                                     resourceAwsAmiImport(),
+			"aws_ami_launch_permission":                          resourceAwsAmiLaunchPermission(),
+			"aws_api_gateway_account":                            resourceAwsApiGatewayAccount(),
+			"aws_api_gateway_api_key":                            resourceAwsApiGatewayApiKey(),
+			"aws_api_gateway_authorizer":                         resourceAwsApiGatewayAuthorizer(),
+			"aws_api_gateway_base_path_mapping":                  resourceAwsApiGatewayBasePathMapping(),
+			"aws_api_gateway_client_certificate":                 resourceAwsApiGatewayClientCertificate(),
+			"aws_api_gateway_deployment":                         resourceAwsApiGatewayDeployment(),
+			"aws_api_gateway_documentation_part":                 resourceAwsApiGatewayDocumentationPart(),
+			"aws_api_gateway_documentation_version":              resourceAwsApiGatewayDocumentationVersion(),
+			"aws_api_gateway_domain_name":                        resourceAwsApiGatewayDomainName(),
+			"aws_api_gateway_gateway_response":                   resourceAwsApiGatewayGatewayResponse(),
+			"aws_api_gateway_integration":                        resourceAwsApiGatewayIntegration(),
+			"aws_api_gateway_integration_response":               resourceAwsApiGatewayIntegrationResponse(),
+			"aws_api_gateway_method":                             resourceAwsApiGatewayMethod(),
+			"aws_api_gateway_method_response":                    resourceAwsApiGatewayMethodResponse(),
+			"aws_api_gateway_method_settings":                    resourceAwsApiGatewayMethodSettings(),
+			"aws_api_gateway_model":                              resourceAwsApiGatewayModel(),
+			"aws_api_gateway_request_validator":                  resourceAwsApiGatewayRequestValidator(),
+			"aws_api_gateway_resource":                           resourceAwsApiGatewayResource(),
+			"aws_api_gateway_rest_api":                           resourceAwsApiGatewayRestApi(),
+			"aws_api_gateway_stage":                              resourceAwsApiGatewayStage(),
+			"aws_api_gateway_usage_plan":                         resourceAwsApiGatewayUsagePlan(),
+			"aws_api_gateway_usage_plan_key":                     resourceAwsApiGatewayUsagePlanKey(),
+			"aws_api_gateway_vpc_link":                           resourceAwsApiGatewayVpcLink(),
+			"aws_app_cookie_stickiness_policy":                   resourceAwsAppCookieStickinessPolicy(),
+			"aws_appautoscaling_target":                          resourceAwsAppautoscalingTarget(),
+			"aws_appautoscaling_policy":                          resourceAwsAppautoscalingPolicy(),
+			"aws_appautoscaling_scheduled_action":                resourceAwsAppautoscalingScheduledAction(),
+			"aws_appsync_graphql_api":                            resourceAwsAppsyncGraphqlApi(),
+			"aws_athena_database":                                resourceAwsAthenaDatabase(),
+			"aws_athena_named_query":                             resourceAwsAthenaNamedQuery(),
+			"aws_autoscaling_attachment":                         resourceAwsAutoscalingAttachment(),
+			"aws_autoscaling_group":                              resourceAwsAutoscalingGroup(),
+			"aws_autoscaling_lifecycle_hook":                     resourceAwsAutoscalingLifecycleHook(),
+			"aws_autoscaling_notification":                       resourceAwsAutoscalingNotification(),
+			"aws_autoscaling_policy":                             resourceAwsAutoscalingPolicy(),
+			"aws_autoscaling_schedule":                           resourceAwsAutoscalingSchedule(),
+			"aws_cloud9_environment_ec2":                         resourceAwsCloud9EnvironmentEc2(),
+			"aws_cloudformation_stack":                           resourceAwsCloudFormationStack(),
+			"aws_cloudfront_distribution":                        resourceAwsCloudFrontDistribution(),
+			"aws_cloudfront_origin_access_identity":              resourceAwsCloudFrontOriginAccessIdentity(),
+			"aws_cloudtrail":                                     resourceAwsCloudTrail(),
+			"aws_cloudwatch_event_permission":                    resourceAwsCloudWatchEventPermission(),
+			"aws_cloudwatch_event_rule":                          resourceAwsCloudWatchEventRule(),
+			"aws_cloudwatch_event_target":                        resourceAwsCloudWatchEventTarget(),
+			"aws_cloudwatch_log_destination":                     resourceAwsCloudWatchLogDestination(),
+			"aws_cloudwatch_log_destination_policy":              resourceAwsCloudWatchLogDestinationPolicy(),
+			"aws_cloudwatch_log_group":                           resourceAwsCloudWatchLogGroup(),
+			"aws_cloudwatch_log_metric_filter":                   resourceAwsCloudWatchLogMetricFilter(),
+			"aws_cloudwatch_log_resource_policy":                 resourceAwsCloudWatchLogResourcePolicy(),
+			"aws_cloudwatch_log_stream":                          resourceAwsCloudWatchLogStream(),
+			"aws_cloudwatch_log_subscription_filter":             resourceAwsCloudwatchLogSubscriptionFilter(),
+			"aws_config_config_rule":                             resourceAwsConfigConfigRule(),
+			"aws_config_configuration_recorder":                  resourceAwsConfigConfigurationRecorder(),
+			"aws_config_configuration_recorder_status":           resourceAwsConfigConfigurationRecorderStatus(),
+			"aws_config_delivery_channel":                        resourceAwsConfigDeliveryChannel(),
+			"aws_cognito_identity_pool":                          resourceAwsCognitoIdentityPool(),
+			"aws_cognito_identity_pool_roles_attachment":         resourceAwsCognitoIdentityPoolRolesAttachment(),
+			"aws_cognito_user_group":                             resourceAwsCognitoUserGroup(),
+			"aws_cognito_user_pool":                              resourceAwsCognitoUserPool(),
+			"aws_cognito_user_pool_client":                       resourceAwsCognitoUserPoolClient(),
+			"aws_cognito_user_pool_domain":                       resourceAwsCognitoUserPoolDomain(),
+			"aws_cloudwatch_metric_alarm":                        resourceAwsCloudWatchMetricAlarm(),
+			"aws_cloudwatch_dashboard":                           resourceAwsCloudWatchDashboard(),
+			"aws_codedeploy_app":                                 resourceAwsCodeDeployApp(),
+			"aws_codedeploy_deployment_config":                   resourceAwsCodeDeployDeploymentConfig(),
+			"aws_codedeploy_deployment_group":                    resourceAwsCodeDeployDeploymentGroup(),
+			"aws_codecommit_repository":                          resourceAwsCodeCommitRepository(),
+			"aws_codecommit_trigger":                             resourceAwsCodeCommitTrigger(),
+			"aws_codebuild_project":                              resourceAwsCodeBuildProject(),
+			"aws_codepipeline":                                   resourceAwsCodePipeline(),
+			"aws_customer_gateway":                               resourceAwsCustomerGateway(),
+			"aws_dax_cluster":                                    resourceAwsDaxCluster(),
+			"aws_db_event_subscription":                          resourceAwsDbEventSubscription(),
+			"aws_db_instance":                                    resourceAwsDbInstance(),
+			"aws_db_option_group":                                resourceAwsDbOptionGroup(),
+			"aws_db_parameter_group":                             resourceAwsDbParameterGroup(),
+			"aws_db_security_group":                              resourceAwsDbSecurityGroup(),
+			"aws_db_snapshot":                                    resourceAwsDbSnapshot(),
+			"aws_db_subnet_group":                                resourceAwsDbSubnetGroup(),
+			"aws_devicefarm_project":                             resourceAwsDevicefarmProject(),
+			"aws_directory_service_directory":                    resourceAwsDirectoryServiceDirectory(),
+			"aws_dms_certificate":                                resourceAwsDmsCertificate(),
+			"aws_dms_endpoint":                                   resourceAwsDmsEndpoint(),
+			"aws_dms_replication_instance":                       resourceAwsDmsReplicationInstance(),
+			"aws_dms_replication_subnet_group":                   resourceAwsDmsReplicationSubnetGroup(),
+			"aws_dms_replication_task":                           resourceAwsDmsReplicationTask(),
+			"aws_dx_lag":                                         resourceAwsDxLag(),
+			"aws_dx_connection":                                  resourceAwsDxConnection(),
+			"aws_dx_connection_association":                      resourceAwsDxConnectionAssociation(),
+			"aws_dynamodb_table":                                 resourceAwsDynamoDbTable(),
+			"aws_dynamodb_table_backup":                          resourceAwsDynamoDbTableBackup(),
+			"aws_dynamodb_table_item":                            resourceAwsDynamoDbTableItem(),
+			"aws_dynamodb_table_items":                           resourceAwsDynamoDbTableItems(),
+			"aws_dynamodb_global_table":                          resourceAwsDynamoDbGlobalTable(),
+			"aws_ebs_snapshot":                                   resourceAwsEbsSnapshot(),
+			"aws_ebs_snapshot_copy":                              resourceAwsEbsSnapshotCopy(),
+			"aws_ebs_volume":                                     resourceAwsEbsVolume(),
+			"aws_ec2_host":                                       resourceAwsEc2Host(),
+			"aws_ec2_reserved_instances":                         resourceAwsEc2ReservedInstances(),
+			"aws_ecr_lifecycle_policy":                           resourceAwsEcrLifecyclePolicy(),
+			"aws_ecr_repository":                                 resourceAwsEcrRepository(),
+			"aws_ecr_repository_policy":                          resourceAwsEcrRepositoryPolicy(),
+			"aws_ecs_cluster":                                    resourceAwsEcsCluster(),
+			"aws_ecs_service":                                    resourceAwsEcsService(),
+			"aws_ecs_task_definition":                            resourceAwsEcsTaskDefinition(),
+			"aws_efs_file_system":                                resourceAwsEfsFileSystem(),
+			"aws_efs_mount_target":                               resourceAwsEfsMountTarget(),
+			"aws_egress_only_internet_gateway":                   resourceAwsEgressOnlyInternetGateway(),
+			"aws_eip":                                            resourceAwsEip(),
+			"aws_eip_association":                                resourceAwsEipAssociation(),
+			"aws_elasticache_cluster":                            resourceAwsElasticacheCluster(),
+			"aws_elasticache_parameter_group":                    resourceAwsElasticacheParameterGroup(),
+			"aws_elasticache_replication_group":                  resourceAwsElasticacheReplicationGroup(),
+			"aws_elasticache_security_group":                     resourceAwsElasticacheSecurityGroup(),
+			"aws_elasticache_subnet_group":                       resourceAwsElasticacheSubnetGroup(),
+			"aws_elastic_beanstalk_application":                  resourceAwsElasticBeanstalkApplication(),
+			"aws_elastic_beanstalk_application_version":          resourceAwsElasticBeanstalkApplicationVersion(),
+			"aws_elastic_beanstalk_configuration_template":       resourceAwsElasticBeanstalkConfigurationTemplate(),
+			"aws_elastic_beanstalk_environment":                  resourceAwsElasticBeanstalkEnvironment(),
+			"aws_elasticsearch_domain":                           resourceAwsElasticSearchDomain(),
+			"aws_elasticsearch_domain_policy":                    resourceAwsElasticSearchDomainPolicy(),
+			"aws_elastictranscoder_pipeline":                     resourceAwsElasticTranscoderPipeline(),
+			"aws_elastictranscoder_preset":                       resourceAwsElasticTranscoderPreset(),
+			"aws_elb":                                            resourceAwsElb(),
+			"aws_elb_attachment":                                 resourceAwsElbAttachment(),
+			"aws_emr_cluster":                                    resourceAwsEMRCluster(),
+			"aws_emr_instance_group":                             resourceAwsEMRInstanceGroup(),
+			"aws_emr_security_configuration":                     resourceAwsEMRSecurityConfiguration(),
+			"aws_flow_log":                                       resourceAwsFlowLog(),
+			"aws_gamelift_alias":                                 resourceAwsGameliftAlias(),
+			"aws_gamelift_build":                                 resourceAwsGameliftBuild(),
+			"aws_gamelift_fleet":                                 resourceAwsGameliftFleet(),
+			"aws_glacier_vault":                                  resourceAwsGlacierVault(),
+			"aws_glue_catalog_database":                          resourceAwsGlueCatalogDatabase(),
+			"aws_guardduty_detector":                             resourceAwsGuardDutyDetector(),
+			"aws_guardduty_ipset":                                resourceAwsGuardDutyIpset(),
+			"aws_guardduty_member":                               resourceAwsGuardDutyMember(),
+			"aws_guardduty_threatintelset":                       resourceAwsGuardDutyThreatintelset(),
+			"aws_iam_access_key":                                 resourceAwsIamAccessKey(),
+			"aws_iam_account_alias":                              resourceAwsIamAccountAlias(),
+			"aws_iam_account_password_policy":                    resourceAwsIamAccountPasswordPolicy(),
+			"aws_iam_group_policy":                               resourceAwsIamGroupPolicy(),
+			"aws_iam_group":                                      resourceAwsIamGroup(),
+			"aws_iam_group_membership":                           resourceAwsIamGroupMembership(),
+			"aws_iam_group_policy_attachment":                    resourceAwsIamGroupPolicyAttachment(),
+			"aws_iam_instance_profile":                           resourceAwsIamInstanceProfile(),
+			"aws_iam_openid_connect_provider":                    resourceAwsIamOpenIDConnectProvider(),
+			"aws_iam_policy":                                     resourceAwsIamPolicy(),
+			"aws_iam_policy_attachment":                          resourceAwsIamPolicyAttachment(),
+			"aws_iam_role_policy_attachment":                     resourceAwsIamRolePolicyAttachment(),
+			"aws_iam_role_policy":                                resourceAwsIamRolePolicy(),
+			"aws_iam_role":                                       resourceAwsIamRole(),
+			"aws_iam_saml_provider":                              resourceAwsIamSamlProvider(),
+			"aws_iam_server_certificate":                         resourceAwsIAMServerCertificate(),
+			"aws_iam_user_policy_attachment":                     resourceAwsIamUserPolicyAttachment(),
+			"aws_iam_user_policy":                                resourceAwsIamUserPolicy(),
+			"aws_iam_user_ssh_key":                               resourceAwsIamUserSshKey(),
+			"aws_iam_user":                                       resourceAwsIamUser(),
+			"aws_iam_user_login_profile":                         resourceAwsIamUserLoginProfile(),
+			"aws_inspector_assessment_target":                    resourceAWSInspectorAssessmentTarget(),
+			"aws_inspector_assessment_template":                  resourceAWSInspectorAssessmentTemplate(),
+			"aws_inspector_resource_group":                       resourceAWSInspectorResourceGroup(),
+			"aws_instance":                                       resourceAwsInstance(),
+			"aws_internet_gateway":                               resourceAwsInternetGateway(),
+			"aws_iot_certificate":                                resourceAwsIotCertificate(),
+			"aws_iot_policy":                                     resourceAwsIotPolicy(),
+			"aws_iot_thing":                                      resourceAwsIotThing(),
+			"aws_iot_thing_type":                                 resourceAwsIotThingType(),
+			"aws_iot_topic_rule":                                 resourceAwsIotTopicRule(),
+			"aws_key_pair":                                       resourceAwsKeyPair(),
+			"aws_kinesis_firehose_delivery_stream":               resourceAwsKinesisFirehoseDeliveryStream(),
+			"aws_kinesis_stream":                                 resourceAwsKinesisStream(),
+			"aws_kms_alias":                                      resourceAwsKmsAlias(),
+			"aws_kms_key":                                        resourceAwsKmsKey(),
+			"aws_lambda_function":                                resourceAwsLambdaFunction(),
+			"aws_lambda_event_source_mapping":                    resourceAwsLambdaEventSourceMapping(),
+			"aws_lambda_alias":                                   resourceAwsLambdaAlias(),
+			"aws_lambda_permission":                              resourceAwsLambdaPermission(),
+			"aws_launch_configuration":                           resourceAwsLaunchConfiguration(),
+			"aws_lightsail_domain":                               resourceAwsLightsailDomain(),
+			"aws_lightsail_instance":                             resourceAwsLightsailInstance(),
+			"aws_lightsail_key_pair":                             resourceAwsLightsailKeyPair(),
+			"aws_lightsail_static_ip":                            resourceAwsLightsailStaticIp(),
+			"aws_lightsail_static_ip_attachment":                 resourceAwsLightsailStaticIpAttachment(),
+			"aws_lb_cookie_stickiness_policy":                    resourceAwsLBCookieStickinessPolicy(),
+			"aws_load_balancer_policy":                           resourceAwsLoadBalancerPolicy(),
+			"aws_load_balancer_backend_server_policy":            resourceAwsLoadBalancerBackendServerPolicies(),
+			"aws_load_balancer_listener_policy":                  resourceAwsLoadBalancerListenerPolicies(),
+			"aws_lb_ssl_negotiation_policy":                      resourceAwsLBSSLNegotiationPolicy(),
+			"aws_main_route_table_association":                   resourceAwsMainRouteTableAssociation(),
+			"aws_mq_broker":                                      resourceAwsMqBroker(),
+			"aws_mq_configuration":                               resourceAwsMqConfiguration(),
+			"aws_media_store_container":                          resourceAwsMediaStoreContainer(),
+			"aws_nat_gateway":                                    resourceAwsNatGateway(),
+			"aws_network_acl":                                    resourceAwsNetworkAcl(),
+			"aws_default_network_acl":                            resourceAwsDefaultNetworkAcl(),
+			"aws_network_acl_rule":                               resourceAwsNetworkAclRule(),
+			"aws_network_interface":                              resourceAwsNetworkInterface(),
+			"aws_network_interface_attachment":                   resourceAwsNetworkInterfaceAttachment(),
+			"aws_opsworks_application":                           resourceAwsOpsworksApplication(),
+			"aws_opsworks_stack":                                 resourceAwsOpsworksStack(),
+			"aws_opsworks_java_app_layer":                        resourceAwsOpsworksJavaAppLayer(),
+			"aws_opsworks_haproxy_layer":                         resourceAwsOpsworksHaproxyLayer(),
+			"aws_opsworks_static_web_layer":                      resourceAwsOpsworksStaticWebLayer(),
+			"aws_opsworks_php_app_layer":                         resourceAwsOpsworksPhpAppLayer(),
+			"aws_opsworks_rails_app_layer":                       resourceAwsOpsworksRailsAppLayer(),
+			"aws_opsworks_nodejs_app_layer":                      resourceAwsOpsworksNodejsAppLayer(),
+			"aws_opsworks_memcached_layer":                       resourceAwsOpsworksMemcachedLayer(),
+			"aws_opsworks_mysql_layer":                           resourceAwsOpsworksMysqlLayer(),
+			"aws_opsworks_ganglia_layer":                         resourceAwsOpsworksGangliaLayer(),
+			"aws_opsworks_custom_layer":                          resourceAwsOpsworksCustomLayer(),
+			"aws_opsworks_instance":                              resourceAwsOpsworksInstance(),
+			"aws_opsworks_user_profile":                          resourceAwsOpsworksUserProfile(),
+			"aws_opsworks_permission":                            resourceAwsOpsworksPermission(),
+			"aws_opsworks_rds_db_instance":                       resourceAwsOpsworksRdsDbInstance(),
+			"aws_organizations_organization":                     resourceAwsOrganizationsOrganization(),
+			"aws_placement_group":                                resourceAwsPlacementGroup(),
+			"aws_proxy_protocol_policy":                          resourceAwsProxyProtocolPolicy(),
+			"aws_rds_cluster":                                    resourceAwsRDSCluster(),
+			"aws_rds_cluster_instance":                           resourceAwsRDSClusterInstance(),
+			"aws_rds_cluster_parameter_group":                    resourceAwsRDSClusterParameterGroup(),
+			"aws_redshift_cluster":                               resourceAwsRedshiftCluster(),
+			"aws_redshift_security_group":                        resourceAwsRedshiftSecurityGroup(),
+			"aws_redshift_parameter_group":                       resourceAwsRedshiftParameterGroup(),
+			"aws_redshift_subnet_group":                          resourceAwsRedshiftSubnetGroup(),
+			"aws_route53_delegation_set":                         resourceAwsRoute53DelegationSet(),
+			"aws_route53_query_log":                              resourceAwsRoute53QueryLog(),
+			"aws_route53_record":                                 resourceAwsRoute53Record(),
+			"aws_route53_zone_association":                       resourceAwsRoute53ZoneAssociation(),
+			"aws_route53_zone":                                   resourceAwsRoute53Zone(),
+			"aws_route53_health_check":                           resourceAwsRoute53HealthCheck(),
+			"aws_route":                                          resourceAwsRoute(),
+			"aws_route_table":                                    resourceAwsRouteTable(),
+			"aws_default_route_table":                            resourceAwsDefaultRouteTable(),
+			"aws_route_table_association":                        resourceAwsRouteTableAssociation(),
+			"aws_ses_active_receipt_rule_set":                    resourceAwsSesActiveReceiptRuleSet(),
+			"aws_ses_domain_identity":                            resourceAwsSesDomainIdentity(),
+			"aws_ses_domain_dkim":                                resourceAwsSesDomainDkim(),
+			"aws_ses_domain_mail_from":                           resourceAwsSesDomainMailFrom(),
+			"aws_ses_receipt_filter":                             resourceAwsSesReceiptFilter(),
+			"aws_ses_receipt_rule":                               resourceAwsSesReceiptRule(),
+			"aws_ses_receipt_rule_set":                           resourceAwsSesReceiptRuleSet(),
+			"aws_ses_configuration_set":                          resourceAwsSesConfigurationSet(),
+			"aws_ses_event_destination":                          resourceAwsSesEventDestination(),
+			"aws_ses_template":                                   resourceAwsSesTemplate(),
+			"aws_s3_bucket":                                      resourceAwsS3Bucket(),
+			"aws_s3_bucket_policy":                               resourceAwsS3BucketPolicy(),
+			"aws_s3_bucket_object":                               resourceAwsS3BucketObject(),
+			"aws_s3_bucket_objects":                              resourceAwsS3BucketObjects(),
+			"aws_s3_bucket_notification":                         resourceAwsS3BucketNotification(),
+			"aws_s3_bucket_metric":                               resourceAwsS3BucketMetric(),
+			"aws_s3_bucket_analytics_configuration":              resourceAwsS3BucketAnalyticsConfiguration(),
+			"aws_s3_bucket_inventory":                            resourceAwsS3BucketInventory(),
+			"aws_s3_bucket_cors_configuration":                   resourceAwsS3BucketCorsConfiguration(),
+			"aws_s3_bucket_lifecycle_configuration":              resourceAwsS3BucketLifecycleConfiguration(),
+			"aws_s3_bucket_logging":                              resourceAwsS3BucketLogging(),
+			"aws_s3_bucket_replication_configuration":            resourceAwsS3BucketReplicationConfiguration(),
+			"aws_s3_bucket_server_side_encryption_configuration": resourceAwsS3BucketServerSideEncryptionConfiguration(),
+			"aws_s3_bucket_versioning":                           resourceAwsS3BucketVersioning(),
+			"aws_s3_bucket_website_configuration":                resourceAwsS3BucketWebsiteConfiguration(),
+			"aws_security_group":                                 resourceAwsSecurityGroup(),
+			"aws_network_interface_sg_attachment":                resourceAwsNetworkInterfaceSGAttachment(),
+			"aws_default_security_group":                         resourceAwsDefaultSecurityGroup(),
+			"aws_security_group_rule":                            resourceAwsSecurityGroupRule(),
+			"aws_servicecatalog_portfolio":                       resourceAwsServiceCatalogPortfolio(),
+			"aws_service_discovery_private_dns_namespace":        resourceAwsServiceDiscoveryPrivateDnsNamespace(),
+			"aws_service_discovery_public_dns_namespace":         resourceAwsServiceDiscoveryPublicDnsNamespace(),
+			"aws_service_discovery_service":                      resourceAwsServiceDiscoveryService(),
+			"aws_simpledb_domain":                                resourceAwsSimpleDBDomain(),
+			"aws_ssm_activation":                                 resourceAwsSsmActivation(),
+			"aws_ssm_association":                                resourceAwsSsmAssociation(),
+			"aws_ssm_document":                                   resourceAwsSsmDocument(),
+			"aws_ssm_maintenance_window":                         resourceAwsSsmMaintenanceWindow(),
+			"aws_ssm_maintenance_window_target":                  resourceAwsSsmMaintenanceWindowTarget(),
+			"aws_ssm_maintenance_window_task":                    resourceAwsSsmMaintenanceWindowTask(),
+			"aws_ssm_patch_baseline":                             resourceAwsSsmPatchBaseline(),
+			"aws_ssm_patch_group":                                resourceAwsSsmPatchGroup(),
+			"aws_ssm_parameter":                                  resourceAwsSsmParameter(),
+			"aws_ssm_resource_data_sync":                         resourceAwsSsmResourceDataSync(),
+			"aws_spot_datafeed_subscription":                     resourceAwsSpotDataFeedSubscription(),
+			"aws_spot_instance_request":                          resourceAwsSpotInstanceRequest(),
+			"aws_spot_fleet_request":                             resourceAwsSpotFleetRequest(),
+			"aws_sqs_queue":                                      resourceAwsSqsQueue(),
+			"aws_sqs_queue_policy":                               resourceAwsSqsQueuePolicy(),
+			"aws_snapshot_create_volume_permission":              resourceAwsSnapshotCreateVolumePermission(),
+			"aws_sns_platform_application":                       resourceAwsSnsPlatformApplication(),
+			"aws_sns_topic":                                      resourceAwsSnsTopic(),
+			"aws_sns_topic_policy":                               resourceAwsSnsTopicPolicy(),
+			"aws_sns_topic_subscription":                         resourceAwsSnsTopicSubscription(),
+			"aws_sfn_activity":                                   resourceAwsSfnActivity(),
+			"aws_sfn_state_machine":                              resourceAwsSfnStateMachine(),
+			"aws_default_subnet":                                 resourceAwsDefaultSubnet(),
+			"aws_subnet":                                         resourceAwsSubnet(),
+			"aws_volume_attachment":                              resourceAwsVolumeAttachment(),
+			"aws_vpc_dhcp_options_association":                   resourceAwsVpcDhcpOptionsAssociation(),
+			"aws_default_vpc_dhcp_options":                       resourceAwsDefaultVpcDhcpOptions(),
+			"aws_vpc_dhcp_options":                               resourceAwsVpcDhcpOptions(),
+			"aws_vpc_peering_connection":                         resourceAwsVpcPeeringConnection(),
+			"aws_vpc_peering_connection_accepter":                resourceAwsVpcPeeringConnectionAccepter(),
+			"aws_default_vpc":                                    resourceAwsDefaultVpc(),
+			"aws_vpc":                                            resourceAwsVpc(),
+			"aws_vpc_endpoint":                                   resourceAwsVpcEndpoint(),
+			"aws_vpc_endpoint_connection_notification":           resourceAwsVpcEndpointConnectionNotification(),
+			"aws_vpc_endpoint_route_table_association":           resourceAwsVpcEndpointRouteTableAssociation(),
+			"aws_vpc_endpoint_subnet_association":                resourceAwsVpcEndpointSubnetAssociation(),
+			"aws_vpc_endpoint_service":                           resourceAwsVpcEndpointService(),
+			"aws_vpc_endpoint_service_allowed_principal":         resourceAwsVpcEndpointServiceAllowedPrincipal(),
+			"aws_vpn_connection":                                 resourceAwsVpnConnection(),
+			"aws_vpn_connection_route":                           resourceAwsVpnConnectionRoute(),
+			"aws_vpn_gateway":                                    resourceAwsVpnGateway(),
+			"aws_vpn_gateway_attachment":                         resourceAwsVpnGatewayAttachment(),
+			"aws_vpn_gateway_route_propagation":                  resourceAwsVpnGatewayRoutePropagation(),
+			"aws_waf_byte_match_set":                             resourceAwsWafByteMatchSet(),
+			"aws_waf_ipset":                                      resourceAwsWafIPSet(),
+			"aws_waf_rule":                                       resourceAwsWafRule(),
+			"aws_waf_rate_based_rule":                            resourceAwsWafRateBasedRule(),
+			"aws_waf_size_constraint_set":                        resourceAwsWafSizeConstraintSet(),
+			"aws_waf_web_acl":                                    resourceAwsWafWebAcl(),
+			"aws_waf_xss_match_set":                              resourceAwsWafXssMatchSet(),
+			"aws_waf_sql_injection_match_set":                    resourceAwsWafSqlInjectionMatchSet(),
+			"aws_wafregional_byte_match_set":                     resourceAwsWafRegionalByteMatchSet(),
+			"aws_wafregional_ipset":                              resourceAwsWafRegionalIPSet(),
+			"aws_batch_compute_environment":                      resourceAwsBatchComputeEnvironment(),
+			"aws_batch_job_definition":                           resourceAwsBatchJobDefinition(),
+			"aws_batch_job_queue":                                resourceAwsBatchJobQueue(),
 
 			// ALBs are actually LBs because they can be type `network` or `application`
 			// To avoid regressions, we will add a new resource for each and they both point
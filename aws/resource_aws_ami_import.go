@@ -0,0 +1,326 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+const (
+	// Converting a disk image is considerably slower than copying an AMI.
+	AWSAMIImportTimeout = 120 * time.Minute
+)
+
+func resourceAwsAmiImport() *schema.Resource {
+	// Inherit all of the common AMI attributes from aws_ami, since we're
+	// implicitly creating an aws_ami resource.
+	resourceSchema := resourceAwsAmiCommonSchema(true)
+
+	// The name of an imported image is chosen by EC2.
+	resourceSchema["name"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Computed: true,
+	}
+
+	// EC2 assigns a description to imported images when none is given.
+	resourceSchema["description"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+		Computed: true,
+	}
+
+	resourceSchema["architecture"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+		Computed: true,
+		ForceNew: true,
+		ValidateFunc: validation.StringInSlice([]string{
+			ec2.ArchitectureValuesI386,
+			ec2.ArchitectureValuesX8664,
+		}, false),
+	}
+
+	// Additional attributes unique to the import operation.
+	resourceSchema["disk_container"] = &schema.Schema{
+		Type:     schema.TypeList,
+		Required: true,
+		ForceNew: true,
+		MinItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"description": {
+					Type:     schema.TypeString,
+					Optional: true,
+					ForceNew: true,
+				},
+				"device_name": {
+					Type:     schema.TypeString,
+					Optional: true,
+					ForceNew: true,
+				},
+				"format": {
+					Type:     schema.TypeString,
+					Required: true,
+					ForceNew: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						"OVA",
+						"VHD",
+						"VMDK",
+						"RAW",
+					}, true),
+				},
+				"url": {
+					Type:     schema.TypeString,
+					Optional: true,
+					ForceNew: true,
+				},
+				"user_bucket": {
+					Type:     schema.TypeList,
+					Optional: true,
+					ForceNew: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"s3_bucket": {
+								Type:     schema.TypeString,
+								Required: true,
+								ForceNew: true,
+							},
+							"s3_key": {
+								Type:     schema.TypeString,
+								Required: true,
+								ForceNew: true,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	resourceSchema["license_type"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+		Computed: true,
+		ForceNew: true,
+		ValidateFunc: validation.StringInSlice([]string{
+			"AWS",
+			"BYOL",
+		}, false),
+	}
+	resourceSchema["platform"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+		Computed: true,
+		ForceNew: true,
+		ValidateFunc: validation.StringInSlice([]string{
+			"Linux",
+			"Windows",
+		}, false),
+	}
+	resourceSchema["hypervisor"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+		Computed: true,
+		ForceNew: true,
+	}
+	resourceSchema["role_name"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+		ForceNew: true,
+	}
+	resourceSchema["import_task_id"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Computed: true,
+	}
+	resourceSchema["snapshot_ids"] = &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem:     &schema.Schema{Type: schema.TypeString},
+	}
+
+	return &schema.Resource{
+		Create: resourceAwsAmiImportCreate,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(AWSAMIImportTimeout),
+			Update: schema.DefaultTimeout(AWSAMIRetryTimeout),
+			Delete: schema.DefaultTimeout(AWSAMIDeleteRetryTimeout),
+		},
+
+		Schema: resourceSchema,
+
+		// The remaining operations are shared with the generic aws_ami resource,
+		// since the aws_ami_import resource only differs in how it's created.
+		Read:   resourceAwsAmiRead,
+		Update: resourceAwsAmiUpdate,
+		Delete: resourceAwsAmiDelete,
+	}
+}
+
+func resourceAwsAmiImportCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*AWSClient).ec2conn
+
+	req := &ec2.ImportImageInput{
+		ClientToken:    aws.String(resource.UniqueId()),
+		DiskContainers: expandAwsAmiImportDiskContainers(d.Get("disk_container").([]interface{})),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		req.Description = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("architecture"); ok {
+		req.Architecture = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("license_type"); ok {
+		req.LicenseType = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("platform"); ok {
+		req.Platform = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("hypervisor"); ok {
+		req.Hypervisor = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("role_name"); ok {
+		req.RoleName = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Importing AMI: %s", req)
+	res, err := client.ImportImage(req)
+	if err != nil {
+		return fmt.Errorf("Error importing AMI: %s", err)
+	}
+
+	taskId := *res.ImportTaskId
+	log.Printf("[INFO] AMI import task ID: %s", taskId)
+
+	task, err := resourceAwsAmiImportWaitForCompleted(d.Timeout(schema.TimeoutCreate), taskId, client)
+	if err != nil {
+		// Don't leave a conversion running that we're no longer tracking.
+		log.Printf("[DEBUG] Cancelling AMI import task %s", taskId)
+		if _, cErr := client.CancelImportTask(&ec2.CancelImportTaskInput{
+			CancelReason: aws.String("Terraform create failed"),
+			ImportTaskId: aws.String(taskId),
+		}); cErr != nil {
+			log.Printf("[WARN] Error cancelling AMI import task %s: %s", taskId, cErr)
+		}
+		return err
+	}
+
+	if task.ImageId == nil {
+		return fmt.Errorf("AMI import task %s completed without an image ID", taskId)
+	}
+
+	id := *task.ImageId
+	d.SetId(id)
+	d.Partial(true) // make sure we record the id even if the rest of this gets interrupted
+	d.Set("import_task_id", taskId)
+	d.SetPartial("import_task_id")
+	d.Set("manage_ebs_snapshots", true)
+	d.SetPartial("manage_ebs_snapshots")
+	d.Partial(false)
+
+	d.Set("license_type", task.LicenseType)
+	d.Set("platform", task.Platform)
+	d.Set("hypervisor", task.Hypervisor)
+
+	snapshotIds := make([]string, 0, len(task.SnapshotDetails))
+	for _, detail := range task.SnapshotDetails {
+		if detail.SnapshotId != nil {
+			snapshotIds = append(snapshotIds, *detail.SnapshotId)
+		}
+	}
+	d.Set("snapshot_ids", snapshotIds)
+
+	_, err = resourceAwsAmiWaitForAvailable(d.Timeout(schema.TimeoutCreate), id, client)
+	if err != nil {
+		return err
+	}
+
+	return resourceAwsAmiUpdate(d, meta)
+}
+
+func expandAwsAmiImportDiskContainers(l []interface{}) []*ec2.ImageDiskContainer {
+	containers := make([]*ec2.ImageDiskContainer, 0, len(l))
+
+	for _, v := range l {
+		m := v.(map[string]interface{})
+
+		container := &ec2.ImageDiskContainer{
+			Format: aws.String(m["format"].(string)),
+		}
+		if v, ok := m["description"].(string); ok && v != "" {
+			container.Description = aws.String(v)
+		}
+		if v, ok := m["device_name"].(string); ok && v != "" {
+			container.DeviceName = aws.String(v)
+		}
+		if v, ok := m["url"].(string); ok && v != "" {
+			container.Url = aws.String(v)
+		}
+		if v, ok := m["user_bucket"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			bucket := v[0].(map[string]interface{})
+			container.UserBucket = &ec2.UserBucket{
+				S3Bucket: aws.String(bucket["s3_bucket"].(string)),
+				S3Key:    aws.String(bucket["s3_key"].(string)),
+			}
+		}
+
+		containers = append(containers, container)
+	}
+
+	return containers
+}
+
+func AMIImportTaskStateRefreshFunc(client *ec2.EC2, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := client.DescribeImportImageTasks(&ec2.DescribeImportImageTasksInput{
+			ImportTaskIds: []*string{aws.String(id)},
+		})
+		if err != nil {
+			return nil, "", fmt.Errorf("Error on refresh: %+v", err)
+		}
+
+		if resp == nil || len(resp.ImportImageTasks) == 0 {
+			// The task may not be visible immediately after creation.
+			return nil, "", nil
+		}
+
+		task := resp.ImportImageTasks[0]
+		status := aws.StringValue(task.Status)
+
+		log.Printf("[INFO] AMI import task %s: %s, %s%% (%s)", id, status,
+			aws.StringValue(task.Progress), aws.StringValue(task.StatusMessage))
+
+		if status == "deleting" || status == "deleted" {
+			return task, status, fmt.Errorf("AMI import task %s was cancelled or failed: %s",
+				id, aws.StringValue(task.StatusMessage))
+		}
+
+		return task, status, nil
+	}
+}
+
+func resourceAwsAmiImportWaitForCompleted(timeout time.Duration, id string, client *ec2.EC2) (*ec2.ImportImageTask, error) {
+	log.Printf("Waiting for AMI import task %s to complete...", id)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"active"},
+		Target:     []string{"completed"},
+		Refresh:    AMIImportTaskStateRefreshFunc(client, id),
+		Timeout:    timeout,
+		Delay:      AWSAMIRetryDelay,
+		MinTimeout: 30 * time.Second,
+	}
+
+	info, err := stateConf.WaitForState()
+	if err != nil {
+		return nil, fmt.Errorf("Error waiting for AMI import task (%s) to complete: %v", id, err)
+	}
+	return info.(*ec2.ImportImageTask), nil
+}
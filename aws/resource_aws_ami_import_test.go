@@ -0,0 +1,179 @@
+package aws
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSAMIImport_basic(t *testing.T) {
+	bucket := os.Getenv("AWS_AMI_IMPORT_S3_BUCKET")
+	key := os.Getenv("AWS_AMI_IMPORT_S3_KEY")
+	if bucket == "" || key == "" {
+		t.Skip("Environment variables AWS_AMI_IMPORT_S3_BUCKET and AWS_AMI_IMPORT_S3_KEY are not set")
+	}
+
+	var image ec2.Image
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAMIImportDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSAMIImportConfig(bucket, key),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAMIImportExists("aws_ami_import.test", &image),
+					resource.TestCheckResourceAttrSet("aws_ami_import.test", "import_task_id"),
+					resource.TestCheckResourceAttr("aws_ami_import.test", "snapshot_ids.#", "1"),
+					resource.TestCheckResourceAttr("aws_ami_import.test", "license_type", "BYOL"),
+					resource.TestCheckResourceAttr("aws_ami_import.test", "description", "terraform-acc-ami-import"),
+					resource.TestCheckResourceAttr("aws_ami_import.test", "tags.Name", "terraform-acc-ami-import"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSAMIImport_withoutDescription(t *testing.T) {
+	bucket := os.Getenv("AWS_AMI_IMPORT_S3_BUCKET")
+	key := os.Getenv("AWS_AMI_IMPORT_S3_KEY")
+	if bucket == "" || key == "" {
+		t.Skip("Environment variables AWS_AMI_IMPORT_S3_BUCKET and AWS_AMI_IMPORT_S3_KEY are not set")
+	}
+
+	var image ec2.Image
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAMIImportDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSAMIImportConfigWithoutDescription(bucket, key),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAMIImportExists("aws_ami_import.test", &image),
+					resource.TestCheckResourceAttrSet("aws_ami_import.test", "description"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSAMIImportExists(n string, image *ec2.Image) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No AMI ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+		resp, err := conn.DescribeImages(&ec2.DescribeImagesInput{
+			ImageIds: []*string{aws.String(rs.Primary.ID)},
+		})
+		if err != nil {
+			return err
+		}
+
+		if len(resp.Images) != 1 || *resp.Images[0].ImageId != rs.Primary.ID {
+			return fmt.Errorf("AMI %s not found", rs.Primary.ID)
+		}
+
+		*image = *resp.Images[0]
+		return nil
+	}
+}
+
+func testAccCheckAWSAMIImportDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).ec2conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_ami_import" {
+			continue
+		}
+
+		resp, err := conn.DescribeImages(&ec2.DescribeImagesInput{
+			ImageIds: []*string{aws.String(rs.Primary.ID)},
+		})
+		if err != nil {
+			if isAWSErr(err, "InvalidAMIID.NotFound", "") {
+				continue
+			}
+			return err
+		}
+
+		if len(resp.Images) > 0 && *resp.Images[0].State != "deregistered" {
+			return fmt.Errorf("AMI %s still exists", rs.Primary.ID)
+		}
+
+		// The imported snapshots are managed along with the AMI.
+		count, _ := strconv.Atoi(rs.Primary.Attributes["snapshot_ids.#"])
+		for i := 0; i < count; i++ {
+			snapshotId := rs.Primary.Attributes[fmt.Sprintf("snapshot_ids.%d", i)]
+			_, err := conn.DescribeSnapshots(&ec2.DescribeSnapshotsInput{
+				SnapshotIds: []*string{aws.String(snapshotId)},
+			})
+			if err == nil {
+				return fmt.Errorf("EBS snapshot %s still exists", snapshotId)
+			}
+			if !isAWSErr(err, "InvalidSnapshot.NotFound", "") {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func testAccAWSAMIImportConfig(bucket, key string) string {
+	return fmt.Sprintf(`
+resource "aws_ami_import" "test" {
+  description  = "terraform-acc-ami-import"
+  license_type = "BYOL"
+
+  disk_container {
+    format = "VMDK"
+
+    user_bucket {
+      s3_bucket = "%s"
+      s3_key    = "%s"
+    }
+  }
+
+  tags {
+    Name = "terraform-acc-ami-import"
+  }
+}
+`, bucket, key)
+}
+
+func testAccAWSAMIImportConfigWithoutDescription(bucket, key string) string {
+	return fmt.Sprintf(`
+resource "aws_ami_import" "test" {
+  license_type = "BYOL"
+
+  disk_container {
+    format = "VMDK"
+
+    user_bucket {
+      s3_bucket = "%s"
+      s3_key    = "%s"
+    }
+  }
+
+  tags {
+    Name = "terraform-acc-ami-import"
+  }
+}
+`, bucket, key)
+}
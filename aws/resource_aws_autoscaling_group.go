@@ -172,6 +172,8 @@ func resourceAwsAutoscalingGroup() *schema.Resource {
 				Optional: true,
 			},
 
+			"rolling_update": autoscalingRollingUpdateSchema(),
+
 			"enabled_metrics": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -717,6 +719,12 @@ func resourceAwsAutoscalingGroupUpdate(d *schema.ResourceData, meta interface{})
 		}
 	}
 
+	if _, ok := d.GetOk("rolling_update"); ok && d.HasChange("launch_configuration") {
+		if err := rollingUpdateASG(d, meta); err != nil {
+			return err
+		}
+	}
+
 	if d.HasChange("enabled_metrics") {
 		if err := updateASGMetricsCollection(d, conn); err != nil {
 			return errwrap.Wrapf("Error updating AutoScaling Group Metrics collection: {{err}}", err)
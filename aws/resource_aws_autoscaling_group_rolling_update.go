@@ -0,0 +1,283 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
+	"github.com/hashicorp/errwrap"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func autoscalingRollingUpdateSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"batch_size": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Default:      1,
+					ValidateFunc: validation.IntAtLeast(1),
+				},
+				"min_healthy_percentage": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Default:      100,
+					ValidateFunc: validation.IntBetween(0, 100),
+				},
+				"pause": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Default:  "0s",
+					ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+						duration, err := time.ParseDuration(v.(string))
+						if err != nil {
+							errors = append(errors, fmt.Errorf(
+								"%q cannot be parsed as a duration: %s", k, err))
+						}
+						if duration < 0 {
+							errors = append(errors, fmt.Errorf(
+								"%q must be greater than zero", k))
+						}
+						return
+					},
+				},
+			},
+		},
+	}
+}
+
+// rollingUpdateBatch works out how many instances to replace in the next
+// batch and how many extra instances must be launched beforehand so that at
+// least min_healthy_percentage of desired capacity stays in service.
+func rollingUpdateBatch(desired, outdated, batchSize, minHealthyPercentage int) (batch, surge int) {
+	batch = batchSize
+	if outdated < batch {
+		batch = outdated
+	}
+
+	minHealthy := (desired*minHealthyPercentage + 99) / 100
+	if spare := desired - minHealthy; batch > spare {
+		surge = batch - spare
+	}
+
+	return batch, surge
+}
+
+// outdatedAutoscalingGroupInstances returns the IDs of instances in the group
+// which were not launched from the given launch configuration.
+func outdatedAutoscalingGroupInstances(g *autoscaling.Group, launchConfiguration string) []string {
+	var ids []string
+	for _, i := range g.Instances {
+		if i.InstanceId == nil {
+			continue
+		}
+		switch aws.StringValue(i.LifecycleState) {
+		case autoscaling.LifecycleStateTerminating,
+			autoscaling.LifecycleStateTerminatingWait,
+			autoscaling.LifecycleStateTerminatingProceed,
+			autoscaling.LifecycleStateTerminated:
+			continue
+		}
+		if aws.StringValue(i.LaunchConfigurationName) != launchConfiguration {
+			ids = append(ids, *i.InstanceId)
+		}
+	}
+	return ids
+}
+
+// capacitySatisfiedRollingUpdate requires at least want instances to be
+// healthy in both the ASG and all of its attached load balancers.
+func capacitySatisfiedRollingUpdate(want int) capacitySatisfiedFunc {
+	return func(d *schema.ResourceData, haveASG, haveELB int) (bool, string) {
+		if haveASG < want {
+			return false, fmt.Sprintf(
+				"Need at least %d healthy instances in ASG, have %d", want, haveASG)
+		}
+		if haveELB < want {
+			return false, fmt.Sprintf(
+				"Need at least %d healthy instances in ELB, have %d", want, haveELB)
+		}
+		return true, ""
+	}
+}
+
+// rollingUpdateASG replaces every instance not running the group's current
+// launch configuration, batch_size instances at a time. Each batch must be
+// healthy in the ASG and its load balancers before the next one starts; if
+// any wait times out the update is aborted and an error is returned.
+func rollingUpdateASG(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).autoscalingconn
+
+	config := d.Get("rolling_update").([]interface{})[0].(map[string]interface{})
+	batchSize := config["batch_size"].(int)
+	minHealthyPercentage := config["min_healthy_percentage"].(int)
+	pause, err := time.ParseDuration(config["pause"].(string))
+	if err != nil {
+		return err
+	}
+
+	wait, err := time.ParseDuration(d.Get("wait_for_capacity_timeout").(string))
+	if err != nil {
+		return err
+	}
+	if wait == 0 {
+		return fmt.Errorf("rolling_update requires a non-zero wait_for_capacity_timeout")
+	}
+
+	launchConfiguration := d.Get("launch_configuration").(string)
+
+	g, err := getAwsAutoscalingGroup(d.Id(), conn)
+	if err != nil {
+		return err
+	}
+	if g == nil {
+		return fmt.Errorf("AutoScaling Group (%s) not found", d.Id())
+	}
+
+	desired := int(aws.Int64Value(g.DesiredCapacity))
+	maxSize := int(aws.Int64Value(g.MaxSize))
+	if desired == 0 {
+		log.Printf("[DEBUG] AutoScaling Group (%s) has no desired capacity, skipping rolling update", d.Id())
+		return nil
+	}
+
+	raisedMaxSize := false
+	for {
+		outdated := outdatedAutoscalingGroupInstances(g, launchConfiguration)
+		if len(outdated) == 0 {
+			break
+		}
+
+		batch, surge := rollingUpdateBatch(desired, len(outdated), batchSize, minHealthyPercentage)
+		log.Printf("[INFO] AutoScaling Group (%s) rolling update: %d outdated instances, replacing %d (surge %d)",
+			d.Id(), len(outdated), batch, surge)
+
+		if surge > 0 {
+			opts := &autoscaling.UpdateAutoScalingGroupInput{
+				AutoScalingGroupName: aws.String(d.Id()),
+				DesiredCapacity:      aws.Int64(int64(desired + surge)),
+			}
+			if desired+surge > maxSize {
+				opts.MaxSize = aws.Int64(int64(desired + surge))
+				raisedMaxSize = true
+			}
+
+			log.Printf("[DEBUG] Raising AutoScaling Group capacity for rolling update: %s", opts)
+			if _, err := conn.UpdateAutoScalingGroup(opts); err != nil {
+				return rollingUpdateASGAbort(d, conn, desired, maxSize, raisedMaxSize, err)
+			}
+
+			if err := waitForASGCapacity(d, meta, capacitySatisfiedRollingUpdate(desired+surge)); err != nil {
+				return rollingUpdateASGAbort(d, conn, desired, maxSize, raisedMaxSize, err)
+			}
+		}
+
+		for n, id := range outdated[:batch] {
+			log.Printf("[DEBUG] Terminating instance %s in AutoScaling Group (%s)", id, d.Id())
+			_, err := conn.TerminateInstanceInAutoScalingGroup(&autoscaling.TerminateInstanceInAutoScalingGroupInput{
+				InstanceId: aws.String(id),
+				// Scale back in by the number of instances we surged by and
+				// let the group replace the rest.
+				ShouldDecrementDesiredCapacity: aws.Bool(n < surge),
+			})
+			if err != nil {
+				return rollingUpdateASGAbort(d, conn, desired, maxSize, raisedMaxSize, err)
+			}
+		}
+
+		if err := waitForASGInstancesOutOfService(d.Id(), conn, outdated[:batch], wait); err != nil {
+			return rollingUpdateASGAbort(d, conn, desired, maxSize, raisedMaxSize, err)
+		}
+
+		if err := waitForASGCapacity(d, meta, capacitySatisfiedRollingUpdate(desired)); err != nil {
+			return rollingUpdateASGAbort(d, conn, desired, maxSize, raisedMaxSize, err)
+		}
+
+		g, err = getAwsAutoscalingGroup(d.Id(), conn)
+		if err != nil {
+			return rollingUpdateASGAbort(d, conn, desired, maxSize, raisedMaxSize, err)
+		}
+		if g == nil {
+			return fmt.Errorf("AutoScaling Group (%s) not found", d.Id())
+		}
+
+		if pause > 0 && len(outdatedAutoscalingGroupInstances(g, launchConfiguration)) > 0 {
+			log.Printf("[DEBUG] Pausing rolling update of AutoScaling Group (%s) for %s", d.Id(), pause)
+			time.Sleep(pause)
+		}
+	}
+
+	if raisedMaxSize {
+		log.Printf("[DEBUG] Restoring AutoScaling Group (%s) max_size to %d", d.Id(), maxSize)
+		_, err := conn.UpdateAutoScalingGroup(&autoscaling.UpdateAutoScalingGroupInput{
+			AutoScalingGroupName: aws.String(d.Id()),
+			MaxSize:              aws.Int64(int64(maxSize)),
+		})
+		if err != nil {
+			return fmt.Errorf("Error restoring AutoScaling Group (%s) max_size: %s", d.Id(), err)
+		}
+	}
+
+	return nil
+}
+
+// rollingUpdateASGAbort makes a best effort to put the group's capacity back
+// to where it was before the rolling update started, and returns the error
+// that caused the update to stop.
+func rollingUpdateASGAbort(d *schema.ResourceData, conn *autoscaling.AutoScaling, desired, maxSize int, raisedMaxSize bool, cause error) error {
+	opts := &autoscaling.UpdateAutoScalingGroupInput{
+		AutoScalingGroupName: aws.String(d.Id()),
+		DesiredCapacity:      aws.Int64(int64(desired)),
+	}
+	if raisedMaxSize {
+		opts.MaxSize = aws.Int64(int64(maxSize))
+	}
+
+	log.Printf("[WARN] Aborting rolling update of AutoScaling Group (%s), restoring capacity: %s", d.Id(), opts)
+	if _, err := conn.UpdateAutoScalingGroup(opts); err != nil {
+		log.Printf("[WARN] Error restoring AutoScaling Group (%s) capacity: %s", d.Id(), err)
+	}
+
+	return errwrap.Wrapf("Error during rolling update of AutoScaling Group: {{err}}", cause)
+}
+
+// waitForASGInstancesOutOfService waits until none of the given instances are
+// reported as InService by the group, so that capacity waiting afterwards
+// doesn't count instances which are about to go away.
+func waitForASGInstancesOutOfService(name string, conn *autoscaling.AutoScaling, ids []string, timeout time.Duration) error {
+	pending := make(map[string]bool, len(ids))
+	for _, id := range ids {
+		pending[id] = true
+	}
+
+	return resource.Retry(timeout, func() *resource.RetryError {
+		g, err := getAwsAutoscalingGroup(name, conn)
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		if g == nil {
+			return nil
+		}
+
+		for _, i := range g.Instances {
+			if !pending[aws.StringValue(i.InstanceId)] {
+				continue
+			}
+			if strings.EqualFold(aws.StringValue(i.LifecycleState), autoscaling.LifecycleStateInService) {
+				return resource.RetryableError(
+					fmt.Errorf("%q: Waiting for instance %s to leave service", name, *i.InstanceId))
+			}
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,116 @@
+package aws
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
+)
+
+func TestRollingUpdateBatch(t *testing.T) {
+	cases := map[string]struct {
+		Desired              int
+		Outdated             int
+		BatchSize            int
+		MinHealthyPercentage int
+		ExpectBatch          int
+		ExpectSurge          int
+	}{
+		"one at a time, all healthy": {
+			Desired:              3,
+			Outdated:             3,
+			BatchSize:            1,
+			MinHealthyPercentage: 100,
+			ExpectBatch:          1,
+			ExpectSurge:          1,
+		},
+		"batch larger than outdated": {
+			Desired:              4,
+			Outdated:             1,
+			BatchSize:            3,
+			MinHealthyPercentage: 100,
+			ExpectBatch:          1,
+			ExpectSurge:          1,
+		},
+		"spare capacity covers batch": {
+			Desired:              4,
+			Outdated:             4,
+			BatchSize:            2,
+			MinHealthyPercentage: 50,
+			ExpectBatch:          2,
+			ExpectSurge:          0,
+		},
+		"spare capacity covers part of batch": {
+			Desired:              4,
+			Outdated:             4,
+			BatchSize:            3,
+			MinHealthyPercentage: 50,
+			ExpectBatch:          3,
+			ExpectSurge:          1,
+		},
+		"min healthy rounds up": {
+			Desired:              3,
+			Outdated:             3,
+			BatchSize:            2,
+			MinHealthyPercentage: 50,
+			ExpectBatch:          2,
+			ExpectSurge:          1,
+		},
+		"no minimum": {
+			Desired:              2,
+			Outdated:             2,
+			BatchSize:            2,
+			MinHealthyPercentage: 0,
+			ExpectBatch:          2,
+			ExpectSurge:          0,
+		},
+	}
+
+	for name, tc := range cases {
+		batch, surge := rollingUpdateBatch(tc.Desired, tc.Outdated, tc.BatchSize, tc.MinHealthyPercentage)
+		if batch != tc.ExpectBatch {
+			t.Errorf("%s: expected batch %d, got %d", name, tc.ExpectBatch, batch)
+		}
+		if surge != tc.ExpectSurge {
+			t.Errorf("%s: expected surge %d, got %d", name, tc.ExpectSurge, surge)
+		}
+	}
+}
+
+func TestOutdatedAutoscalingGroupInstances(t *testing.T) {
+	g := &autoscaling.Group{
+		Instances: []*autoscaling.Instance{
+			{
+				InstanceId:              aws.String("i-current"),
+				LaunchConfigurationName: aws.String("new"),
+				LifecycleState:          aws.String(autoscaling.LifecycleStateInService),
+			},
+			{
+				InstanceId:              aws.String("i-old"),
+				LaunchConfigurationName: aws.String("old"),
+				LifecycleState:          aws.String(autoscaling.LifecycleStateInService),
+			},
+			{
+				InstanceId:              aws.String("i-old-pending"),
+				LaunchConfigurationName: aws.String("old"),
+				LifecycleState:          aws.String(autoscaling.LifecycleStatePending),
+			},
+			{
+				InstanceId:              aws.String("i-old-terminating"),
+				LaunchConfigurationName: aws.String("old"),
+				LifecycleState:          aws.String(autoscaling.LifecycleStateTerminating),
+			},
+			{
+				// Instances whose launch configuration was deleted have none.
+				InstanceId:     aws.String("i-orphaned"),
+				LifecycleState: aws.String(autoscaling.LifecycleStateInService),
+			},
+		},
+	}
+
+	expected := []string{"i-old", "i-old-pending", "i-orphaned"}
+	if actual := outdatedAutoscalingGroupInstances(g, "new"); !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("expected %v, got %v", expected, actual)
+	}
+}
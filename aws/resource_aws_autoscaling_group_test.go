@@ -378,6 +378,42 @@ func TestAccAWSAutoScalingGroup_withPlacementGroup(t *testing.T) {
 	})
 }
 
+func TestAccAWSAutoScalingGroup_rollingUpdate(t *testing.T) {
+	var group autoscaling.Group
+
+	randName := fmt.Sprintf("tf-rolling-update-%s", acctest.RandString(5))
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAutoScalingGroupDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccAWSAutoScalingGroupConfig_rollingUpdate(randName, "foo"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAutoScalingGroupExists("aws_autoscaling_group.bar", &group),
+					testAccCheckAWSAutoScalingGroupInstancesLaunchConfiguration(&group, "aws_launch_configuration.foo"),
+					resource.TestCheckResourceAttr(
+						"aws_autoscaling_group.bar", "rolling_update.#", "1"),
+					resource.TestCheckResourceAttr(
+						"aws_autoscaling_group.bar", "rolling_update.0.batch_size", "1"),
+					resource.TestCheckResourceAttr(
+						"aws_autoscaling_group.bar", "rolling_update.0.min_healthy_percentage", "50"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccAWSAutoScalingGroupConfig_rollingUpdate(randName, "bar"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAutoScalingGroupExists("aws_autoscaling_group.bar", &group),
+					testAccCheckAWSAutoScalingGroupInstancesLaunchConfiguration(&group, "aws_launch_configuration.bar"),
+					testAccCheckAWSAutoScalingGroupHealthyCapacity(&group, 2),
+					resource.TestCheckResourceAttr(
+						"aws_autoscaling_group.bar", "max_size", "2"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAWSAutoScalingGroup_enablingMetrics(t *testing.T) {
 	var group autoscaling.Group
 	randName := fmt.Sprintf("terraform-test-%s", acctest.RandString(10))
@@ -761,6 +797,24 @@ func testLaunchConfigurationName(n string, lc *autoscaling.LaunchConfiguration)
 	}
 }
 
+func testAccCheckAWSAutoScalingGroupInstancesLaunchConfiguration(g *autoscaling.Group, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		for _, i := range g.Instances {
+			if aws.StringValue(i.LaunchConfigurationName) != rs.Primary.ID {
+				return fmt.Errorf("Instance %s launched from %q, expected %q",
+					aws.StringValue(i.InstanceId), aws.StringValue(i.LaunchConfigurationName), rs.Primary.ID)
+			}
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckAWSAutoScalingGroupHealthyCapacity(
 	g *autoscaling.Group, exp int) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
@@ -1431,6 +1485,51 @@ resource "aws_autoscaling_group" "bar" {
 `, name, name)
 }
 
+func testAccAWSAutoScalingGroupConfig_rollingUpdate(name, lc string) string {
+	return fmt.Sprintf(`
+data "aws_ami" "test_ami" {
+  most_recent = true
+
+  filter {
+    name   = "owner-alias"
+    values = ["amazon"]
+  }
+
+  filter {
+    name   = "name"
+    values = ["amzn-ami-hvm-*-x86_64-gp2"]
+  }
+}
+
+resource "aws_launch_configuration" "foo" {
+  image_id = "${data.aws_ami.test_ami.id}"
+  instance_type = "t2.micro"
+}
+
+resource "aws_launch_configuration" "bar" {
+  image_id = "${data.aws_ami.test_ami.id}"
+  instance_type = "t2.nano"
+}
+
+resource "aws_autoscaling_group" "bar" {
+  availability_zones = ["us-west-2a"]
+  name = "%s"
+  max_size = 2
+  min_size = 2
+  desired_capacity = 2
+  force_delete = true
+
+  launch_configuration = "${aws_launch_configuration.%s.name}"
+
+  rolling_update {
+    batch_size = 1
+    min_healthy_percentage = 50
+    pause = "10s"
+  }
+}
+`, name, lc)
+}
+
 const testAccAWSAutoscalingMetricsCollectionConfig_allMetricsCollected = `
 data "aws_ami" "test_ami" {
   most_recent = true
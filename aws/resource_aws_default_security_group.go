@@ -31,6 +31,14 @@ func resourceAwsDefaultSecurityGroup() *schema.Resource {
 	// rules
 	dsg.Schema["ingress"].Computed = false
 	dsg.Schema["egress"].Computed = false
+	delete(dsg.Schema, "rule_management")
+	dsg.CustomizeDiff = nil
+
+	// Rules always belong to the default group itself, so importing it must
+	// not fan out into aws_security_group_rule resources.
+	dsg.Importer = &schema.ResourceImporter{
+		State: schema.ImportStatePassthrough,
+	}
 	return dsg
 }
 
@@ -196,6 +196,28 @@ func resourceAwsDynamoDbTable() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"restore_source_backup_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+			"server_side_encryption": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:     schema.TypeBool,
+							Required: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
 			"tags": tagsSchema(),
 		},
 	}
@@ -204,6 +226,10 @@ func resourceAwsDynamoDbTable() *schema.Resource {
 func resourceAwsDynamoDbTableCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).dynamodbconn
 
+	if v, ok := d.GetOk("restore_source_backup_arn"); ok {
+		return resourceAwsDynamoDbTableRestore(d, meta, v.(string))
+	}
+
 	keySchemaMap := map[string]interface{}{
 		"hash_key": d.Get("hash_key").(string),
 	}
@@ -250,6 +276,10 @@ func resourceAwsDynamoDbTableCreate(d *schema.ResourceData, meta interface{}) er
 		}
 	}
 
+	if v, ok := d.GetOk("server_side_encryption"); ok {
+		req.SSESpecification = expandDynamoDbTableServerSideEncryption(v.([]interface{}))
+	}
+
 	var output *dynamodb.CreateTableOutput
 	err := resource.Retry(2*time.Minute, func() *resource.RetryError {
 		var err error
@@ -283,6 +313,183 @@ func resourceAwsDynamoDbTableCreate(d *schema.ResourceData, meta interface{}) er
 	return resourceAwsDynamoDbTableUpdate(d, meta)
 }
 
+// resourceAwsDynamoDbTableRestore creates the table from an on-demand backup.
+// Key schema, attributes, indexes and encryption come from the backup, while
+// the provisioned throughput (of the table and its global secondary indexes)
+// and stream settings are brought in line with the configuration once the
+// table is active.
+func resourceAwsDynamoDbTableRestore(d *schema.ResourceData, meta interface{}, backupArn string) error {
+	conn := meta.(*AWSClient).dynamodbconn
+
+	backup, err := conn.DescribeBackup(&dynamodb.DescribeBackupInput{
+		BackupArn: aws.String(backupArn),
+	})
+	if err != nil {
+		return fmt.Errorf("error reading DynamoDB table backup %q: %s", backupArn, err)
+	}
+
+	keySchemaMap := map[string]interface{}{
+		"hash_key": d.Get("hash_key").(string),
+	}
+	if v, ok := d.GetOk("range_key"); ok {
+		keySchemaMap["range_key"] = v.(string)
+	}
+	var sse []interface{}
+	if v, ok := d.GetOk("server_side_encryption"); ok {
+		sse = v.([]interface{})
+	}
+	if err := checkDynamoDbTableRestoreSource(expandDynamoDbKeySchema(keySchemaMap), sse, backup.BackupDescription); err != nil {
+		return fmt.Errorf("cannot restore DynamoDB table from backup %q: %s", backupArn, err)
+	}
+
+	input := &dynamodb.RestoreTableFromBackupInput{
+		BackupArn:       aws.String(backupArn),
+		TargetTableName: aws.String(d.Get("name").(string)),
+	}
+
+	log.Printf("[DEBUG] Restoring DynamoDB table from backup: %s", input)
+	var output *dynamodb.RestoreTableFromBackupOutput
+	err = resource.Retry(2*time.Minute, func() *resource.RetryError {
+		var err error
+		output, err = conn.RestoreTableFromBackup(input)
+		if err != nil {
+			if isAWSErr(err, "ThrottlingException", "") {
+				return resource.RetryableError(err)
+			}
+			if isAWSErr(err, dynamodb.ErrCodeLimitExceededException, "") {
+				return resource.RetryableError(err)
+			}
+			if isAWSErr(err, dynamodb.ErrCodeBackupInUseException, "") {
+				return resource.RetryableError(err)
+			}
+
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("error restoring DynamoDB table from backup %q: %s", backupArn, err)
+	}
+
+	d.SetId(*output.TableDescription.TableName)
+	d.Set("arn", output.TableDescription.TableArn)
+
+	if err := waitForDynamoDbTableToBeActive(d.Id(), d.Timeout(schema.TimeoutCreate), conn); err != nil {
+		return err
+	}
+
+	readCapacity := int64(d.Get("read_capacity").(int))
+	writeCapacity := int64(d.Get("write_capacity").(int))
+	throughput := output.TableDescription.ProvisionedThroughput
+	if throughput == nil || aws.Int64Value(throughput.ReadCapacityUnits) != readCapacity || aws.Int64Value(throughput.WriteCapacityUnits) != writeCapacity {
+		_, err := conn.UpdateTable(&dynamodb.UpdateTableInput{
+			TableName: aws.String(d.Id()),
+			ProvisionedThroughput: expandDynamoDbProvisionedThroughput(map[string]interface{}{
+				"read_capacity":  d.Get("read_capacity"),
+				"write_capacity": d.Get("write_capacity"),
+			}),
+		})
+		if err != nil {
+			return err
+		}
+		if err := waitForDynamoDbTableToBeActive(d.Id(), d.Timeout(schema.TimeoutCreate), conn); err != nil {
+			return fmt.Errorf("Error waiting for DynamoDB Table update: %s", err)
+		}
+	}
+
+	restoredGsis := make(map[string]*dynamodb.ProvisionedThroughputDescription)
+	for _, gsi := range output.TableDescription.GlobalSecondaryIndexes {
+		restoredGsis[aws.StringValue(gsi.IndexName)] = gsi.ProvisionedThroughput
+	}
+	for _, v := range d.Get("global_secondary_index").(*schema.Set).List() {
+		gsi := v.(map[string]interface{})
+		idxName := gsi["name"].(string)
+		throughput, ok := restoredGsis[idxName]
+		if !ok {
+			continue
+		}
+		if aws.Int64Value(throughput.ReadCapacityUnits) == int64(gsi["read_capacity"].(int)) &&
+			aws.Int64Value(throughput.WriteCapacityUnits) == int64(gsi["write_capacity"].(int)) {
+			continue
+		}
+
+		_, err := conn.UpdateTable(&dynamodb.UpdateTableInput{
+			TableName: aws.String(d.Id()),
+			GlobalSecondaryIndexUpdates: []*dynamodb.GlobalSecondaryIndexUpdate{
+				{
+					Update: &dynamodb.UpdateGlobalSecondaryIndexAction{
+						IndexName:             aws.String(idxName),
+						ProvisionedThroughput: expandDynamoDbProvisionedThroughput(gsi),
+					},
+				},
+			},
+		})
+		if err != nil {
+			return err
+		}
+		if err := waitForDynamoDbGSIToBeActive(d.Id(), idxName, conn); err != nil {
+			return fmt.Errorf("Error waiting for DynamoDB GSI %q to be updated: %s", idxName, err)
+		}
+		if err := waitForDynamoDbTableToBeActive(d.Id(), d.Timeout(schema.TimeoutCreate), conn); err != nil {
+			return fmt.Errorf("Error waiting for DynamoDB Table update: %s", err)
+		}
+	}
+
+	if d.Get("stream_enabled").(bool) {
+		_, err := conn.UpdateTable(&dynamodb.UpdateTableInput{
+			TableName: aws.String(d.Id()),
+			StreamSpecification: &dynamodb.StreamSpecification{
+				StreamEnabled:  aws.Bool(true),
+				StreamViewType: aws.String(d.Get("stream_view_type").(string)),
+			},
+		})
+		if err != nil {
+			return err
+		}
+		if err := waitForDynamoDbTableToBeActive(d.Id(), d.Timeout(schema.TimeoutCreate), conn); err != nil {
+			return fmt.Errorf("Error waiting for DynamoDB Table update: %s", err)
+		}
+	}
+
+	return resourceAwsDynamoDbTableUpdate(d, meta)
+}
+
+// checkDynamoDbTableRestoreSource returns an error if the configured key schema
+// or server-side encryption doesn't match the backup the table is restored
+// from. Both are taken from the backup and force a new table when changed, so
+// a mismatch would otherwise replace and restore the table on every apply.
+func checkDynamoDbTableRestoreSource(keySchema []*dynamodb.KeySchemaElement, sse []interface{}, backup *dynamodb.BackupDescription) error {
+	if source := backup.SourceTableDetails; source != nil {
+		configured := make(map[string]string)
+		for _, e := range keySchema {
+			configured[aws.StringValue(e.KeyType)] = aws.StringValue(e.AttributeName)
+		}
+		backedUp := make(map[string]string)
+		for _, e := range source.KeySchema {
+			backedUp[aws.StringValue(e.KeyType)] = aws.StringValue(e.AttributeName)
+		}
+		for _, keyType := range []string{dynamodb.KeyTypeHash, dynamodb.KeyTypeRange} {
+			if configured[keyType] != backedUp[keyType] {
+				return fmt.Errorf("%s key %q does not match the backup's %q", strings.ToLower(keyType), configured[keyType], backedUp[keyType])
+			}
+		}
+	}
+
+	if len(sse) > 0 && sse[0] != nil {
+		var description *dynamodb.SSEDescription
+		if features := backup.SourceTableFeatureDetails; features != nil {
+			description = features.SSEDescription
+		}
+		enabled := sse[0].(map[string]interface{})["enabled"].(bool)
+		backedUp := flattenDynamoDbTableServerSideEncryption(description)[0].(map[string]interface{})["enabled"].(bool)
+		if enabled != backedUp {
+			return fmt.Errorf("server_side_encryption enabled = %t does not match the backup's %t", enabled, backedUp)
+		}
+	}
+
+	return nil
+}
+
 func resourceAwsDynamoDbTableUpdate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).dynamodbconn
 
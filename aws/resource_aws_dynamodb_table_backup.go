@@ -0,0 +1,186 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsDynamoDbTableBackup() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsDynamoDbTableBackupCreate,
+		Read:   resourceAwsDynamoDbTableBackupRead,
+		Delete: resourceAwsDynamoDbTableBackupDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAwsDynamoDbTableBackupName,
+			},
+			"table_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"table_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"size_bytes": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"creation_date_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsDynamoDbTableBackupCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).dynamodbconn
+
+	input := &dynamodb.CreateBackupInput{
+		BackupName: aws.String(d.Get("name").(string)),
+		TableName:  aws.String(d.Get("table_name").(string)),
+	}
+
+	log.Printf("[DEBUG] Creating DynamoDB table backup: %s", input)
+	var output *dynamodb.CreateBackupOutput
+	// Backups are unavailable for a short while after a table has been created.
+	err := resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		var err error
+		output, err = conn.CreateBackup(input)
+		if err != nil {
+			if isAWSErr(err, dynamodb.ErrCodeContinuousBackupsUnavailableException, "") {
+				return resource.RetryableError(err)
+			}
+			if isAWSErr(err, dynamodb.ErrCodeLimitExceededException, "") {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("error creating DynamoDB table backup: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.BackupDetails.BackupArn))
+
+	log.Printf("[INFO] Waiting for DynamoDB table backup %q to be available", d.Id())
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{dynamodb.BackupStatusCreating},
+		Target:     []string{dynamodb.BackupStatusAvailable},
+		Refresh:    resourceAwsDynamoDbTableBackupStateRefreshFunc(conn, d.Id()),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		MinTimeout: 5 * time.Second,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("error waiting for DynamoDB table backup %q to be available: %s", d.Id(), err)
+	}
+
+	return resourceAwsDynamoDbTableBackupRead(d, meta)
+}
+
+func resourceAwsDynamoDbTableBackupRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).dynamodbconn
+
+	output, err := conn.DescribeBackup(&dynamodb.DescribeBackupInput{
+		BackupArn: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, dynamodb.ErrCodeBackupNotFoundException, "") {
+			log.Printf("[WARN] DynamoDB table backup %q not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("error reading DynamoDB table backup %q: %s", d.Id(), err)
+	}
+
+	details := output.BackupDescription.BackupDetails
+	if aws.StringValue(details.BackupStatus) == dynamodb.BackupStatusDeleted {
+		log.Printf("[WARN] DynamoDB table backup %q has been deleted, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("arn", details.BackupArn)
+	d.Set("name", details.BackupName)
+	d.Set("size_bytes", details.BackupSizeBytes)
+	d.Set("status", details.BackupStatus)
+	if details.BackupCreationDateTime != nil {
+		d.Set("creation_date_time", details.BackupCreationDateTime.Format(time.RFC3339))
+	}
+
+	if source := output.BackupDescription.SourceTableDetails; source != nil {
+		d.Set("table_name", source.TableName)
+		d.Set("table_arn", source.TableArn)
+	}
+
+	return nil
+}
+
+func resourceAwsDynamoDbTableBackupDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).dynamodbconn
+
+	log.Printf("[DEBUG] Deleting DynamoDB table backup: %s", d.Id())
+	// A backup can't be deleted while a table is being restored from it.
+	err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+		_, err := conn.DeleteBackup(&dynamodb.DeleteBackupInput{
+			BackupArn: aws.String(d.Id()),
+		})
+		if err != nil {
+			if isAWSErr(err, dynamodb.ErrCodeBackupInUseException, "") {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		if isAWSErr(err, dynamodb.ErrCodeBackupNotFoundException, "") {
+			return nil
+		}
+		return fmt.Errorf("error deleting DynamoDB table backup %q: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceAwsDynamoDbTableBackupStateRefreshFunc(conn *dynamodb.DynamoDB, arn string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := conn.DescribeBackup(&dynamodb.DescribeBackupInput{
+			BackupArn: aws.String(arn),
+		})
+		if err != nil {
+			return 42, "", err
+		}
+
+		details := output.BackupDescription.BackupDetails
+		return details, aws.StringValue(details.BackupStatus), nil
+	}
+}
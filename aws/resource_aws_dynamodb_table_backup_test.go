@@ -0,0 +1,166 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSDynamoDbTableBackup_basic(t *testing.T) {
+	resourceName := "aws_dynamodb_table_backup.test"
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(8))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsDynamoDbTableBackupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDynamoDbTableBackupConfig(rName, "!!!!"),
+				ExpectError: regexp.MustCompile("name must only include alphanumeric, underscore, period, or hyphen characters"),
+			},
+			{
+				Config: testAccDynamoDbTableBackupConfig(rName, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsDynamoDbTableBackupExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "table_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "status", dynamodb.BackupStatusAvailable),
+					resource.TestMatchResourceAttr(resourceName, "arn",
+						regexp.MustCompile("^arn:aws:dynamodb:[a-z0-9-]+:[0-9]{12}:table/[a-zA-Z0-9_.-]+/backup/[0-9a-z-]+$")),
+					resource.TestCheckResourceAttrPair(resourceName, "table_arn", "aws_dynamodb_table.test", "arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "creation_date_time"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSDynamoDbTableBackup_restore(t *testing.T) {
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(8))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsDynamoDbTableBackupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDynamoDbTableBackupConfig_restore(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsDynamoDbTableBackupExists("aws_dynamodb_table_backup.test"),
+					resource.TestCheckResourceAttr("aws_dynamodb_table.restored", "name", rName+"-restored"),
+					resource.TestCheckResourceAttr("aws_dynamodb_table.restored", "hash_key", "TestTableHashKey"),
+					resource.TestCheckResourceAttr("aws_dynamodb_table.restored", "read_capacity", "2"),
+					resource.TestCheckResourceAttr("aws_dynamodb_table.restored", "write_capacity", "2"),
+					resource.TestCheckResourceAttr("aws_dynamodb_table.restored", "tags.%", "1"),
+					resource.TestCheckResourceAttrPair("aws_dynamodb_table.restored", "restore_source_backup_arn", "aws_dynamodb_table_backup.test", "arn"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAwsDynamoDbTableBackupDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).dynamodbconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_dynamodb_table_backup" {
+			continue
+		}
+
+		output, err := conn.DescribeBackup(&dynamodb.DescribeBackupInput{
+			BackupArn: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			if isAWSErr(err, dynamodb.ErrCodeBackupNotFoundException, "") {
+				continue
+			}
+			return err
+		}
+
+		if aws.StringValue(output.BackupDescription.BackupDetails.BackupStatus) != dynamodb.BackupStatusDeleted {
+			return fmt.Errorf("DynamoDB table backup %q still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAwsDynamoDbTableBackupExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No DynamoDB table backup ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).dynamodbconn
+
+		_, err := conn.DescribeBackup(&dynamodb.DescribeBackupInput{
+			BackupArn: aws.String(rs.Primary.ID),
+		})
+
+		return err
+	}
+}
+
+func testAccDynamoDbTableBackupConfigTable(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_dynamodb_table" "test" {
+  name           = "%s"
+  read_capacity  = 1
+  write_capacity = 1
+  hash_key       = "TestTableHashKey"
+
+  attribute {
+    name = "TestTableHashKey"
+    type = "S"
+  }
+}
+`, rName)
+}
+
+func testAccDynamoDbTableBackupConfig(rName, backupName string) string {
+	return testAccDynamoDbTableBackupConfigTable(rName) + fmt.Sprintf(`
+resource "aws_dynamodb_table_backup" "test" {
+  name       = "%s"
+  table_name = "${aws_dynamodb_table.test.name}"
+}
+`, backupName)
+}
+
+func testAccDynamoDbTableBackupConfig_restore(rName string) string {
+	return testAccDynamoDbTableBackupConfig(rName, rName) + fmt.Sprintf(`
+resource "aws_dynamodb_table" "restored" {
+  name           = "%s-restored"
+  read_capacity  = 2
+  write_capacity = 2
+  hash_key       = "TestTableHashKey"
+
+  attribute {
+    name = "TestTableHashKey"
+    type = "S"
+  }
+
+  restore_source_backup_arn = "${aws_dynamodb_table_backup.test.arn}"
+
+  tags {
+    Name = "%s-restored"
+  }
+}
+`, rName, rName)
+}
@@ -0,0 +1,499 @@
+package aws
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"log"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+const (
+	// BatchWriteItem accepts at most 25 put or delete requests
+	dynamoDbBatchWriteItemLimit = 25
+	// BatchGetItem accepts at most 100 keys
+	dynamoDbBatchGetItemLimit = 100
+)
+
+func resourceAwsDynamoDbTableItems() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsDynamoDbTableItemsCreate,
+		Read:   resourceAwsDynamoDbTableItemsRead,
+		Update: resourceAwsDynamoDbTableItemsUpdate,
+		Delete: resourceAwsDynamoDbTableItemsDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"table_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"hash_key": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"range_key": {
+				Type:     schema.TypeString,
+				ForceNew: true,
+				Optional: true,
+			},
+			"items": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validateDynamoDbTableItems,
+				DiffSuppressFunc: suppressEquivalentDynamoDbTableItems,
+			},
+		},
+	}
+}
+
+func validateDynamoDbTableItems(v interface{}, k string) (ws []string, errors []error) {
+	_, err := expandDynamoDbTableItemsList(v.(string))
+	if err != nil {
+		errors = append(errors, fmt.Errorf("Invalid format of %q: %s", k, err))
+	}
+	return
+}
+
+// suppressEquivalentDynamoDbTableItems ignores differences in the order of the
+// items and in the formatting of the JSON.
+func suppressEquivalentDynamoDbTableItems(k, old, new string, d *schema.ResourceData) bool {
+	hashKey := d.Get("hash_key").(string)
+	rangeKey := d.Get("range_key").(string)
+
+	oldItems, err := expandDynamoDbTableItemsByKey(old, hashKey, rangeKey)
+	if err != nil {
+		return false
+	}
+	newItems, err := expandDynamoDbTableItemsByKey(new, hashKey, rangeKey)
+	if err != nil {
+		return false
+	}
+
+	puts, deletes := diffDynamoDbTableItems(oldItems, newItems)
+	return len(puts) == 0 && len(deletes) == 0
+}
+
+func resourceAwsDynamoDbTableItemsCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).dynamodbconn
+
+	tableName := d.Get("table_name").(string)
+	hashKey := d.Get("hash_key").(string)
+	rangeKey := d.Get("range_key").(string)
+
+	items, err := expandDynamoDbTableItemsByKey(d.Get("items").(string), hashKey, rangeKey)
+	if err != nil {
+		return err
+	}
+
+	puts, _ := diffDynamoDbTableItems(nil, items)
+
+	log.Printf("[DEBUG] Writing %d DynamoDB items to table %s", len(puts), tableName)
+	if err := writeDynamoDbTableItems(conn, tableName, puts, nil, hashKey, rangeKey, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error writing DynamoDB items to table %q: %s", tableName, err)
+	}
+
+	d.SetId(resource.PrefixedUniqueId(fmt.Sprintf("%s-", tableName)))
+
+	return resourceAwsDynamoDbTableItemsRead(d, meta)
+}
+
+func resourceAwsDynamoDbTableItemsUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).dynamodbconn
+
+	if d.HasChange("items") {
+		tableName := d.Get("table_name").(string)
+		hashKey := d.Get("hash_key").(string)
+		rangeKey := d.Get("range_key").(string)
+
+		o, n := d.GetChange("items")
+		oldItems, err := expandDynamoDbTableItemsByKey(o.(string), hashKey, rangeKey)
+		if err != nil {
+			return err
+		}
+		newItems, err := expandDynamoDbTableItemsByKey(n.(string), hashKey, rangeKey)
+		if err != nil {
+			return err
+		}
+
+		// Only the items whose primary key is new or whose attributes changed
+		// are rewritten; items no longer in the list are deleted.
+		puts, deletes := diffDynamoDbTableItems(oldItems, newItems)
+
+		log.Printf("[DEBUG] Updating DynamoDB table %s: %d items to write, %d items to delete", tableName, len(puts), len(deletes))
+		if err := writeDynamoDbTableItems(conn, tableName, puts, deletes, hashKey, rangeKey, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return fmt.Errorf("error updating DynamoDB items in table %q: %s", tableName, err)
+		}
+	}
+
+	return resourceAwsDynamoDbTableItemsRead(d, meta)
+}
+
+func resourceAwsDynamoDbTableItemsRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).dynamodbconn
+
+	tableName := d.Get("table_name").(string)
+	hashKey := d.Get("hash_key").(string)
+	rangeKey := d.Get("range_key").(string)
+
+	configured, err := expandDynamoDbTableItemsList(d.Get("items").(string))
+	if err != nil {
+		return err
+	}
+
+	keys := make([]map[string]*dynamodb.AttributeValue, 0, len(configured))
+	for _, item := range configured {
+		keys = append(keys, buildDynamoDbTableItemQueryKey(item, hashKey, rangeKey))
+	}
+
+	remote, err := readDynamoDbTableItems(conn, tableName, keys, hashKey, rangeKey)
+	if err != nil {
+		if isAWSErr(err, dynamodb.ErrCodeResourceNotFoundException, "") {
+			log.Printf("[WARN] DynamoDB table %s not found, removing items (%s) from state", tableName, d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error retrieving DynamoDB items from table %q: %s", tableName, err)
+	}
+
+	// Rebuild the list in the configured order. Items which no longer exist
+	// are dropped so they're written again, and only the configured
+	// attributes are compared so attributes added elsewhere are ignored.
+	changed := false
+	items := make([]map[string]*dynamodb.AttributeValue, 0, len(configured))
+	for _, item := range configured {
+		id := buildDynamoDbTableItemId(tableName, hashKey, rangeKey, item)
+		remoteItem, ok := remote[id]
+		if !ok {
+			log.Printf("[WARN] DynamoDB item %s not found", id)
+			changed = true
+			continue
+		}
+
+		current := make(map[string]*dynamodb.AttributeValue, len(item))
+		for name := range item {
+			if v, ok := remoteItem[name]; ok {
+				current[name] = v
+			}
+		}
+
+		if !dynamoDbTableItemsEqual(item, current) {
+			changed = true
+		}
+		items = append(items, current)
+	}
+
+	if changed {
+		flattened, err := flattenDynamoDbTableItemsList(items)
+		if err != nil {
+			return err
+		}
+		d.Set("items", flattened)
+	}
+
+	return nil
+}
+
+func resourceAwsDynamoDbTableItemsDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).dynamodbconn
+
+	tableName := d.Get("table_name").(string)
+	hashKey := d.Get("hash_key").(string)
+	rangeKey := d.Get("range_key").(string)
+
+	items, err := expandDynamoDbTableItemsByKey(d.Get("items").(string), hashKey, rangeKey)
+	if err != nil {
+		return err
+	}
+
+	_, deletes := diffDynamoDbTableItems(items, nil)
+
+	log.Printf("[DEBUG] Deleting %d DynamoDB items from table %s", len(deletes), tableName)
+	err = writeDynamoDbTableItems(conn, tableName, nil, deletes, hashKey, rangeKey, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		if isAWSErr(err, dynamodb.ErrCodeResourceNotFoundException, "") {
+			return nil
+		}
+		return fmt.Errorf("error deleting DynamoDB items from table %q: %s", tableName, err)
+	}
+
+	return nil
+}
+
+// Helpers
+
+// writeDynamoDbTableItems puts and deletes the given items in batches,
+// resubmitting whatever DynamoDB reports as unprocessed.
+func writeDynamoDbTableItems(conn *dynamodb.DynamoDB, tableName string, puts, deletes []map[string]*dynamodb.AttributeValue, hashKey, rangeKey string, timeout time.Duration) error {
+	requests := make([]*dynamodb.WriteRequest, 0, len(puts)+len(deletes))
+	for _, item := range deletes {
+		requests = append(requests, &dynamodb.WriteRequest{
+			DeleteRequest: &dynamodb.DeleteRequest{
+				Key: buildDynamoDbTableItemQueryKey(item, hashKey, rangeKey),
+			},
+		})
+	}
+	for _, item := range puts {
+		requests = append(requests, &dynamodb.WriteRequest{
+			PutRequest: &dynamodb.PutRequest{
+				Item: item,
+			},
+		})
+	}
+
+	for len(requests) > 0 {
+		n := len(requests)
+		if n > dynamoDbBatchWriteItemLimit {
+			n = dynamoDbBatchWriteItemLimit
+		}
+		batch := requests[:n]
+		requests = requests[n:]
+
+		input := &dynamodb.BatchWriteItemInput{
+			RequestItems: map[string][]*dynamodb.WriteRequest{
+				tableName: batch,
+			},
+		}
+
+		err := resource.Retry(timeout, func() *resource.RetryError {
+			output, err := conn.BatchWriteItem(input)
+			if err != nil {
+				if isAWSErr(err, dynamodb.ErrCodeProvisionedThroughputExceededException, "") {
+					return resource.RetryableError(err)
+				}
+				return resource.NonRetryableError(err)
+			}
+
+			if unprocessed := output.UnprocessedItems[tableName]; len(unprocessed) > 0 {
+				input.RequestItems = map[string][]*dynamodb.WriteRequest{
+					tableName: unprocessed,
+				}
+				return resource.RetryableError(fmt.Errorf("%d DynamoDB items unprocessed", len(unprocessed)))
+			}
+
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// readDynamoDbTableItems reads the items with the given keys in batches and
+// returns those that exist, indexed by item ID.
+func readDynamoDbTableItems(conn *dynamodb.DynamoDB, tableName string, keys []map[string]*dynamodb.AttributeValue, hashKey, rangeKey string) (map[string]map[string]*dynamodb.AttributeValue, error) {
+	items := make(map[string]map[string]*dynamodb.AttributeValue, len(keys))
+
+	for len(keys) > 0 {
+		n := len(keys)
+		if n > dynamoDbBatchGetItemLimit {
+			n = dynamoDbBatchGetItemLimit
+		}
+		batch := keys[:n]
+		keys = keys[n:]
+
+		input := &dynamodb.BatchGetItemInput{
+			RequestItems: map[string]*dynamodb.KeysAndAttributes{
+				tableName: {
+					ConsistentRead: aws.Bool(true),
+					Keys:           batch,
+				},
+			},
+		}
+
+		err := resource.Retry(2*time.Minute, func() *resource.RetryError {
+			output, err := conn.BatchGetItem(input)
+			if err != nil {
+				if isAWSErr(err, dynamodb.ErrCodeProvisionedThroughputExceededException, "") {
+					return resource.RetryableError(err)
+				}
+				return resource.NonRetryableError(err)
+			}
+
+			for _, item := range output.Responses[tableName] {
+				items[buildDynamoDbTableItemId(tableName, hashKey, rangeKey, item)] = item
+			}
+
+			if unprocessed, ok := output.UnprocessedKeys[tableName]; ok && len(unprocessed.Keys) > 0 {
+				input.RequestItems = map[string]*dynamodb.KeysAndAttributes{
+					tableName: unprocessed,
+				}
+				return resource.RetryableError(fmt.Errorf("%d DynamoDB keys unprocessed", len(unprocessed.Keys)))
+			}
+
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return items, nil
+}
+
+// diffDynamoDbTableItems returns the items to write, i.e. those that are new
+// or changed, and the items to delete, i.e. those whose key was removed.
+func diffDynamoDbTableItems(oldItems, newItems map[string]map[string]*dynamodb.AttributeValue) (puts, deletes []map[string]*dynamodb.AttributeValue) {
+	for _, id := range sortedDynamoDbTableItemIds(newItems) {
+		newItem := newItems[id]
+		if oldItem, ok := oldItems[id]; !ok || !dynamoDbTableItemsEqual(oldItem, newItem) {
+			puts = append(puts, newItem)
+		}
+	}
+
+	for _, id := range sortedDynamoDbTableItemIds(oldItems) {
+		if _, ok := newItems[id]; !ok {
+			deletes = append(deletes, oldItems[id])
+		}
+	}
+
+	return
+}
+
+func sortedDynamoDbTableItemIds(items map[string]map[string]*dynamodb.AttributeValue) []string {
+	ids := make([]string, 0, len(items))
+	for id := range items {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+// dynamoDbTableItemsEqual compares two items, ignoring the order of the
+// members of string, number and binary sets.
+func dynamoDbTableItemsEqual(a, b map[string]*dynamodb.AttributeValue) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for name, av := range a {
+		bv, ok := b[name]
+		if !ok {
+			return false
+		}
+		if !reflect.DeepEqual(normalizeDynamoDbAttributeValue(av), normalizeDynamoDbAttributeValue(bv)) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func normalizeDynamoDbAttributeValue(v *dynamodb.AttributeValue) *dynamodb.AttributeValue {
+	if v == nil {
+		return nil
+	}
+
+	n := *v
+
+	if v.SS != nil {
+		n.SS = sortedDynamoDbStringSet(v.SS)
+	}
+	if v.NS != nil {
+		n.NS = sortedDynamoDbStringSet(v.NS)
+	}
+	if v.BS != nil {
+		bs := make([][]byte, len(v.BS))
+		copy(bs, v.BS)
+		sort.Slice(bs, func(i, j int) bool {
+			return bytes.Compare(bs[i], bs[j]) < 0
+		})
+		n.BS = bs
+	}
+	if v.L != nil {
+		l := make([]*dynamodb.AttributeValue, len(v.L))
+		for i, e := range v.L {
+			l[i] = normalizeDynamoDbAttributeValue(e)
+		}
+		n.L = l
+	}
+	if v.M != nil {
+		m := make(map[string]*dynamodb.AttributeValue, len(v.M))
+		for k, e := range v.M {
+			m[k] = normalizeDynamoDbAttributeValue(e)
+		}
+		n.M = m
+	}
+
+	return &n
+}
+
+func sortedDynamoDbStringSet(in []*string) []*string {
+	values := make([]string, len(in))
+	for i, s := range in {
+		values[i] = aws.StringValue(s)
+	}
+	sort.Strings(values)
+	return aws.StringSlice(values)
+}
+
+func expandDynamoDbTableItemsList(input string) ([]map[string]*dynamodb.AttributeValue, error) {
+	var items []map[string]*dynamodb.AttributeValue
+
+	dec := json.NewDecoder(strings.NewReader(input))
+	err := dec.Decode(&items)
+	if err != nil {
+		return nil, fmt.Errorf("Decoding failed: %s", err)
+	}
+
+	return items, nil
+}
+
+// expandDynamoDbTableItemsByKey indexes the items by their primary key,
+// requiring every item to have the key attributes and no key to repeat.
+func expandDynamoDbTableItemsByKey(input, hashKey, rangeKey string) (map[string]map[string]*dynamodb.AttributeValue, error) {
+	list, err := expandDynamoDbTableItemsList(input)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make(map[string]map[string]*dynamodb.AttributeValue, len(list))
+	for i, item := range list {
+		if _, ok := item[hashKey]; !ok {
+			return nil, fmt.Errorf("item %d is missing the hash key attribute %q", i, hashKey)
+		}
+		if rangeKey != "" {
+			if _, ok := item[rangeKey]; !ok {
+				return nil, fmt.Errorf("item %d is missing the range key attribute %q", i, rangeKey)
+			}
+		}
+
+		id := buildDynamoDbTableItemId("", hashKey, rangeKey, item)
+		if _, ok := items[id]; ok {
+			return nil, fmt.Errorf("item %d has the same primary key as a previous item", i)
+		}
+		items[id] = item
+	}
+
+	return items, nil
+}
+
+func flattenDynamoDbTableItemsList(items []map[string]*dynamodb.AttributeValue) (string, error) {
+	flattened := make([]string, 0, len(items))
+	for _, item := range items {
+		attrs, err := flattenDynamoDbTableItemAttributes(item)
+		if err != nil {
+			return "", err
+		}
+		flattened = append(flattened, strings.TrimSpace(attrs))
+	}
+
+	return "[" + strings.Join(flattened, ",") + "]", nil
+}
@@ -0,0 +1,300 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestDiffDynamoDbTableItems(t *testing.T) {
+	oldItems, err := expandDynamoDbTableItemsByKey(`[
+	{"id": {"S": "unchanged"}, "value": {"N": "1"}},
+	{"id": {"S": "changed"}, "value": {"N": "2"}},
+	{"id": {"S": "removed"}, "value": {"N": "3"}}
+]`, "id", "")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	newItems, err := expandDynamoDbTableItemsByKey(`[
+	{"id": {"S": "added"}, "value": {"N": "4"}},
+	{"id": {"S": "changed"}, "value": {"N": "20"}},
+	{"id": {"S": "unchanged"}, "value": {"N": "1"}}
+]`, "id", "")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	puts, deletes := diffDynamoDbTableItems(oldItems, newItems)
+
+	if len(puts) != 2 {
+		t.Fatalf("expected 2 items to write, got %d: %v", len(puts), puts)
+	}
+	if v := aws.StringValue(puts[0]["id"].S); v != "added" {
+		t.Errorf("expected first write to be %q, got %q", "added", v)
+	}
+	if v := aws.StringValue(puts[1]["value"].N); v != "20" {
+		t.Errorf("expected changed item to be written with value %q, got %q", "20", v)
+	}
+
+	if len(deletes) != 1 {
+		t.Fatalf("expected 1 item to delete, got %d: %v", len(deletes), deletes)
+	}
+	if v := aws.StringValue(deletes[0]["id"].S); v != "removed" {
+		t.Errorf("expected %q to be deleted, got %q", "removed", v)
+	}
+}
+
+func TestExpandDynamoDbTableItemsByKey(t *testing.T) {
+	testCases := []struct {
+		Items       string
+		HashKey     string
+		RangeKey    string
+		ExpectedLen int
+		ErrCount    int
+	}{
+		{
+			Items:       `[{"id": {"S": "a"}, "sort": {"N": "1"}}, {"id": {"S": "a"}, "sort": {"N": "2"}}]`,
+			HashKey:     "id",
+			RangeKey:    "sort",
+			ExpectedLen: 2,
+		},
+		{
+			Items:       `[{"id": {"B": "AQID"}}, {"id": {"N": "1"}}]`,
+			HashKey:     "id",
+			ExpectedLen: 2,
+		},
+		{
+			Items:    `[{"id": {"S": "a"}}, {"id": {"S": "a"}}]`,
+			HashKey:  "id",
+			ErrCount: 1,
+		},
+		{
+			Items:    `[{"other": {"S": "a"}}]`,
+			HashKey:  "id",
+			ErrCount: 1,
+		},
+		{
+			Items:    `[{"id": {"S": "a"}}]`,
+			HashKey:  "id",
+			RangeKey: "sort",
+			ErrCount: 1,
+		},
+		{
+			Items:    `{"id": {"S": "a"}}`,
+			HashKey:  "id",
+			ErrCount: 1,
+		},
+	}
+
+	for i, tc := range testCases {
+		items, err := expandDynamoDbTableItemsByKey(tc.Items, tc.HashKey, tc.RangeKey)
+		if tc.ErrCount == 0 && err != nil {
+			t.Fatalf("Case #%d: unexpected error: %s", i, err)
+		}
+		if tc.ErrCount > 0 && err == nil {
+			t.Fatalf("Case #%d: expected an error", i)
+		}
+		if len(items) != tc.ExpectedLen {
+			t.Fatalf("Case #%d: expected %d items, got %d", i, tc.ExpectedLen, len(items))
+		}
+	}
+}
+
+func TestDynamoDbTableItemsEqual(t *testing.T) {
+	a, err := expandDynamoDbTableItemAttributes(`{
+	"id": {"S": "a"},
+	"tags": {"SS": ["x", "y", "z"]},
+	"sizes": {"NS": ["1", "2"]},
+	"nested": {"M": {"colors": {"SS": ["red", "blue"]}, "list": {"L": [{"S": "1"}, {"BOOL": true}, {"NULL": true}]}}}
+}`)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	b, err := expandDynamoDbTableItemAttributes(`{
+	"nested": {"M": {"list": {"L": [{"S": "1"}, {"BOOL": true}, {"NULL": true}]}, "colors": {"SS": ["blue", "red"]}}},
+	"sizes": {"NS": ["2", "1"]},
+	"tags": {"SS": ["z", "x", "y"]},
+	"id": {"S": "a"}
+}`)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if !dynamoDbTableItemsEqual(a, b) {
+		t.Fatalf("expected items to be equal")
+	}
+
+	b["nested"].M["list"].L[1].BOOL = aws.Bool(false)
+	if dynamoDbTableItemsEqual(a, b) {
+		t.Fatalf("expected items to differ")
+	}
+}
+
+func TestAccAWSDynamoDbTableItems_basic(t *testing.T) {
+	tableName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(8))
+	items := `[
+	{"hashKey": {"S": "a"}, "rangeKey": {"N": "1"}, "string": {"S": "one"}, "bool": {"BOOL": true}},
+	{"hashKey": {"S": "a"}, "rangeKey": {"N": "2"}, "set": {"SS": ["x", "y"]}, "null": {"NULL": true}},
+	{"hashKey": {"S": "b"}, "rangeKey": {"N": "1"}, "map": {"M": {"list": {"L": [{"N": "1"}, {"S": "two"}]}}}, "binary": {"B": "AQID"}}
+]`
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSDynamoDbTableItemsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSDynamoDbTableItemsConfig(tableName, items),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSDynamoDbTableItemsExist("aws_dynamodb_table_items.test"),
+					testAccCheckAWSDynamoDbTableItemCount(tableName, 3),
+					resource.TestCheckResourceAttr("aws_dynamodb_table_items.test", "table_name", tableName),
+					resource.TestCheckResourceAttr("aws_dynamodb_table_items.test", "hash_key", "hashKey"),
+					resource.TestCheckResourceAttr("aws_dynamodb_table_items.test", "range_key", "rangeKey"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSDynamoDbTableItems_update(t *testing.T) {
+	tableName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(8))
+	itemsBefore := `[
+	{"hashKey": {"S": "a"}, "rangeKey": {"N": "1"}, "value": {"S": "unchanged"}},
+	{"hashKey": {"S": "a"}, "rangeKey": {"N": "2"}, "value": {"S": "before"}},
+	{"hashKey": {"S": "b"}, "rangeKey": {"N": "1"}, "value": {"S": "removed"}}
+]`
+	itemsAfter := `[
+	{"hashKey": {"S": "a"}, "rangeKey": {"N": "2"}, "value": {"S": "after"}},
+	{"hashKey": {"S": "a"}, "rangeKey": {"N": "1"}, "value": {"S": "unchanged"}},
+	{"hashKey": {"S": "c"}, "rangeKey": {"N": "1"}, "value": {"S": "added"}}
+]`
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSDynamoDbTableItemsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSDynamoDbTableItemsConfig(tableName, itemsBefore),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSDynamoDbTableItemsExist("aws_dynamodb_table_items.test"),
+					testAccCheckAWSDynamoDbTableItemCount(tableName, 3),
+				),
+			},
+			{
+				Config: testAccAWSDynamoDbTableItemsConfig(tableName, itemsAfter),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSDynamoDbTableItemsExist("aws_dynamodb_table_items.test"),
+					testAccCheckAWSDynamoDbTableItemCount(tableName, 3),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSDynamoDbTableItemsDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).dynamodbconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_dynamodb_table_items" {
+			continue
+		}
+
+		attrs := rs.Primary.Attributes
+		items, err := expandDynamoDbTableItemsList(attrs["items"])
+		if err != nil {
+			return err
+		}
+
+		keys := make([]map[string]*dynamodb.AttributeValue, 0, len(items))
+		for _, item := range items {
+			keys = append(keys, buildDynamoDbTableItemQueryKey(item, attrs["hash_key"], attrs["range_key"]))
+		}
+
+		remote, err := readDynamoDbTableItems(conn, attrs["table_name"], keys, attrs["hash_key"], attrs["range_key"])
+		if err != nil {
+			if isAWSErr(err, dynamodb.ErrCodeResourceNotFoundException, "") {
+				continue
+			}
+			return fmt.Errorf("Error retrieving DynamoDB items: %s", err)
+		}
+		if len(remote) > 0 {
+			return fmt.Errorf("%d DynamoDB items of %s still exist", len(remote), rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAWSDynamoDbTableItemsExist(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No DynamoDB table items ID specified!")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).dynamodbconn
+
+		attrs := rs.Primary.Attributes
+		items, err := expandDynamoDbTableItemsByKey(attrs["items"], attrs["hash_key"], attrs["range_key"])
+		if err != nil {
+			return err
+		}
+
+		keys := make([]map[string]*dynamodb.AttributeValue, 0, len(items))
+		for _, item := range items {
+			keys = append(keys, buildDynamoDbTableItemQueryKey(item, attrs["hash_key"], attrs["range_key"]))
+		}
+
+		remote, err := readDynamoDbTableItems(conn, attrs["table_name"], keys, attrs["hash_key"], attrs["range_key"])
+		if err != nil {
+			return fmt.Errorf("[ERROR] Problem getting table items '%s': %s", rs.Primary.ID, err)
+		}
+		if len(remote) != len(items) {
+			return fmt.Errorf("Expected %d DynamoDB items, got %d", len(items), len(remote))
+		}
+
+		return nil
+	}
+}
+
+func testAccAWSDynamoDbTableItemsConfig(tableName, items string) string {
+	return fmt.Sprintf(`
+resource "aws_dynamodb_table" "test" {
+  name = "%s"
+  read_capacity = 10
+  write_capacity = 10
+  hash_key = "hashKey"
+  range_key = "rangeKey"
+
+  attribute {
+    name = "hashKey"
+    type = "S"
+  }
+
+  attribute {
+    name = "rangeKey"
+    type = "N"
+  }
+}
+
+resource "aws_dynamodb_table_items" "test" {
+  table_name = "${aws_dynamodb_table.test.name}"
+  hash_key = "${aws_dynamodb_table.test.hash_key}"
+  range_key = "${aws_dynamodb_table.test.range_key}"
+  items = <<ITEMS
+%s
+ITEMS
+}
+`, tableName, items)
+}
@@ -327,6 +327,80 @@ func TestDiffDynamoDbGSI(t *testing.T) {
 	}
 }
 
+func TestCheckDynamoDbTableRestoreSource(t *testing.T) {
+	backup := &dynamodb.BackupDescription{
+		SourceTableDetails: &dynamodb.SourceTableDetails{
+			KeySchema: expandDynamoDbKeySchema(map[string]interface{}{
+				"hash_key":  "id",
+				"range_key": "sort",
+			}),
+		},
+		SourceTableFeatureDetails: &dynamodb.SourceTableFeatureDetails{},
+	}
+	enabled := []interface{}{map[string]interface{}{"enabled": true}}
+	disabled := []interface{}{map[string]interface{}{"enabled": false}}
+
+	testCases := []struct {
+		HashKey  string
+		RangeKey string
+		SSE      []interface{}
+		ErrCount int
+	}{
+		{
+			HashKey:  "id",
+			RangeKey: "sort",
+		},
+		{
+			HashKey:  "id",
+			RangeKey: "sort",
+			SSE:      disabled,
+		},
+		{
+			HashKey:  "id",
+			RangeKey: "sort",
+			SSE:      enabled,
+			ErrCount: 1,
+		},
+		{
+			HashKey:  "other",
+			RangeKey: "sort",
+			ErrCount: 1,
+		},
+		{
+			HashKey:  "id",
+			ErrCount: 1,
+		},
+	}
+
+	for i, tc := range testCases {
+		keySchema := expandDynamoDbKeySchema(map[string]interface{}{
+			"hash_key":  tc.HashKey,
+			"range_key": tc.RangeKey,
+		})
+		err := checkDynamoDbTableRestoreSource(keySchema, tc.SSE, backup)
+		if tc.ErrCount == 0 && err != nil {
+			t.Fatalf("Case #%d: unexpected error: %s", i, err)
+		}
+		if tc.ErrCount > 0 && err == nil {
+			t.Fatalf("Case #%d: expected an error", i)
+		}
+	}
+
+	backup.SourceTableFeatureDetails.SSEDescription = &dynamodb.SSEDescription{
+		Status: aws.String(dynamodb.SSEStatusEnabled),
+	}
+	keySchema := expandDynamoDbKeySchema(map[string]interface{}{
+		"hash_key":  "id",
+		"range_key": "sort",
+	})
+	if err := checkDynamoDbTableRestoreSource(keySchema, enabled, backup); err != nil {
+		t.Fatalf("unexpected error for encrypted backup: %s", err)
+	}
+	if err := checkDynamoDbTableRestoreSource(keySchema, disabled, backup); err == nil {
+		t.Fatalf("expected an error for encrypted backup")
+	}
+}
+
 func TestAccAWSDynamoDbTable_basic(t *testing.T) {
 	var conf dynamodb.DescribeTableOutput
 
@@ -402,6 +476,42 @@ func TestAccAWSDynamoDbTable_streamSpecificationValidation(t *testing.T) {
 	})
 }
 
+func TestAccAWSDynamoDbTable_encryption(t *testing.T) {
+	var confEncEnabled, confEncDisabled dynamodb.DescribeTableOutput
+
+	rName := acctest.RandomWithPrefix("TerraformTestTable-")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSDynamoDbTableDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSDynamoDbConfigInitialStateWithEncryption(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInitialAWSDynamoDbTableExists("aws_dynamodb_table.basic-dynamodb-table", &confEncEnabled),
+					resource.TestCheckResourceAttr("aws_dynamodb_table.basic-dynamodb-table", "server_side_encryption.#", "1"),
+					resource.TestCheckResourceAttr("aws_dynamodb_table.basic-dynamodb-table", "server_side_encryption.0.enabled", "true"),
+				),
+			},
+			{
+				Config: testAccAWSDynamoDbConfigInitialStateWithEncryption(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInitialAWSDynamoDbTableExists("aws_dynamodb_table.basic-dynamodb-table", &confEncDisabled),
+					resource.TestCheckResourceAttr("aws_dynamodb_table.basic-dynamodb-table", "server_side_encryption.#", "1"),
+					resource.TestCheckResourceAttr("aws_dynamodb_table.basic-dynamodb-table", "server_side_encryption.0.enabled", "false"),
+					func(s *terraform.State) error {
+						if confEncDisabled.Table.CreationDateTime.Equal(*confEncEnabled.Table.CreationDateTime) {
+							return fmt.Errorf("DynamoDB table not recreated when changing SSE")
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
 func TestAccAWSDynamoDbTable_tags(t *testing.T) {
 	var conf dynamodb.DescribeTableOutput
 
@@ -1039,6 +1149,26 @@ resource "aws_dynamodb_table" "basic-dynamodb-table" {
 `, tableName, enabled, viewType)
 }
 
+func testAccAWSDynamoDbConfigInitialStateWithEncryption(tableName string, enabled bool) string {
+	return fmt.Sprintf(`
+resource "aws_dynamodb_table" "basic-dynamodb-table" {
+  name = "%s"
+  read_capacity = 1
+  write_capacity = 1
+  hash_key = "TestTableHashKey"
+
+  attribute {
+    name = "TestTableHashKey"
+    type = "S"
+  }
+
+  server_side_encryption {
+    enabled = %t
+  }
+}
+`, tableName, enabled)
+}
+
 func testAccAWSDynamoDbConfigTags() string {
 	return fmt.Sprintf(`
 resource "aws_dynamodb_table" "basic-dynamodb-table" {
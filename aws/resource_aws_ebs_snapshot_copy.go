@@ -0,0 +1,212 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsEbsSnapshotCopy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsEbsSnapshotCopyCreate,
+		Read:   resourceAwsEbsSnapshotCopyRead,
+		Update: resourceAwsEbsSnapshotCopyUpdate,
+		Delete: resourceAwsEbsSnapshotDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(40 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"source_snapshot_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"source_region": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"encrypted": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"kms_key_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+			"owner_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"owner_alias": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"volume_size": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"data_encryption_key_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceAwsEbsSnapshotCopyCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	request := &ec2.CopySnapshotInput{
+		SourceRegion:     aws.String(d.Get("source_region").(string)),
+		SourceSnapshotId: aws.String(d.Get("source_snapshot_id").(string)),
+	}
+	if v, ok := d.GetOk("description"); ok {
+		request.Description = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("encrypted"); ok {
+		request.Encrypted = aws.Bool(v.(bool))
+	}
+	if v, ok := d.GetOk("kms_key_id"); ok {
+		request.KmsKeyId = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Copying EBS snapshot: %s", request)
+	res, err := conn.CopySnapshot(request)
+	if err != nil {
+		return fmt.Errorf("Error copying EBS snapshot: %s", err)
+	}
+
+	d.SetId(*res.SnapshotId)
+
+	err = resourceAwsEbsSnapshotCopyWaitForCompleted(d.Timeout(schema.TimeoutCreate), d.Id(), conn)
+	if err != nil {
+		return err
+	}
+
+	if err := setTags(conn, d); err != nil {
+		return err
+	}
+
+	return resourceAwsEbsSnapshotCopyRead(d, meta)
+}
+
+func resourceAwsEbsSnapshotCopyRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	res, err := conn.DescribeSnapshots(&ec2.DescribeSnapshotsInput{
+		SnapshotIds: []*string{aws.String(d.Id())},
+	})
+	if err != nil {
+		if isAWSErr(err, "InvalidSnapshot.NotFound", "") {
+			log.Printf("[WARN] EBS snapshot %q not found - removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	if len(res.Snapshots) == 0 {
+		log.Printf("[WARN] EBS snapshot %q not found - removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	snapshot := res.Snapshots[0]
+
+	d.Set("description", snapshot.Description)
+	d.Set("owner_id", snapshot.OwnerId)
+	d.Set("encrypted", snapshot.Encrypted)
+	d.Set("owner_alias", snapshot.OwnerAlias)
+	d.Set("data_encryption_key_id", snapshot.DataEncryptionKeyId)
+	d.Set("kms_key_id", snapshot.KmsKeyId)
+	d.Set("volume_size", snapshot.VolumeSize)
+
+	if err := d.Set("tags", tagsToMap(snapshot.Tags)); err != nil {
+		return fmt.Errorf("Error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsEbsSnapshotCopyUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	if err := setTags(conn, d); err != nil {
+		return err
+	}
+
+	return resourceAwsEbsSnapshotCopyRead(d, meta)
+}
+
+func resourceAwsEbsSnapshotCopyWaitForCompleted(timeout time.Duration, id string, conn *ec2.EC2) error {
+	log.Printf("Waiting for EBS snapshot copy %s to complete...", id)
+
+	// Cross-region copies of large snapshots can take far longer than the
+	// SDK's WaitUntilSnapshotCompleted allows, so we poll on our own timeout.
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{ec2.SnapshotStatePending},
+		Target:     []string{ec2.SnapshotStateCompleted},
+		Refresh:    EbsSnapshotStateRefreshFunc(conn, id),
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for EBS snapshot copy (%s) to complete: %s", id, err)
+	}
+
+	return nil
+}
+
+// EbsSnapshotStateRefreshFunc returns a resource.StateRefreshFunc that is
+// used to watch the progress of an EBS snapshot.
+func EbsSnapshotStateRefreshFunc(conn *ec2.EC2, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		res, err := conn.DescribeSnapshots(&ec2.DescribeSnapshotsInput{
+			SnapshotIds: []*string{aws.String(id)},
+		})
+		if err != nil {
+			if isAWSErr(err, "InvalidSnapshot.NotFound", "") {
+				// The copy may not be visible immediately after creation.
+				return nil, "", nil
+			}
+			return nil, "", err
+		}
+
+		if len(res.Snapshots) == 0 {
+			return nil, "", nil
+		}
+
+		snapshot := res.Snapshots[0]
+		state := aws.StringValue(snapshot.State)
+		log.Printf("[DEBUG] EBS snapshot %s: %s, %s", id, state, aws.StringValue(snapshot.Progress))
+
+		if state == ec2.SnapshotStateError {
+			return snapshot, state, fmt.Errorf("EBS snapshot %s failed: %s", id, aws.StringValue(snapshot.StateMessage))
+		}
+
+		return snapshot, state, nil
+	}
+}
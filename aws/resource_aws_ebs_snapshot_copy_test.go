@@ -0,0 +1,187 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSEbsSnapshotCopy_basic(t *testing.T) {
+	var v ec2.Snapshot
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckEbsSnapshotCopyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsEbsSnapshotCopyConfig("foo"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSnapshotExists("aws_ebs_snapshot_copy.test", &v),
+					testAccCheckTags(&v.Tags, "Name", "foo"),
+					resource.TestCheckResourceAttr("aws_ebs_snapshot_copy.test", "description", "Copy of testAccAwsEbsSnapshotCopyConfig"),
+					resource.TestCheckResourceAttr("aws_ebs_snapshot_copy.test", "volume_size", "1"),
+				),
+			},
+			{
+				Config: testAccAwsEbsSnapshotCopyConfig("bar"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSnapshotExists("aws_ebs_snapshot_copy.test", &v),
+					testAccCheckTags(&v.Tags, "Name", "bar"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSEbsSnapshotCopy_withoutDescription(t *testing.T) {
+	var v ec2.Snapshot
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckEbsSnapshotCopyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsEbsSnapshotCopyConfigWithoutDescription,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSnapshotExists("aws_ebs_snapshot_copy.test", &v),
+					resource.TestMatchResourceAttr("aws_ebs_snapshot_copy.test", "description",
+						regexp.MustCompile("^\\[Copied snap-[0-9a-f]+ from [a-z0-9-]+\\]")),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSEbsSnapshotCopy_withKms(t *testing.T) {
+	var v ec2.Snapshot
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckEbsSnapshotCopyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsEbsSnapshotCopyConfigWithKms,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSnapshotExists("aws_ebs_snapshot_copy.test", &v),
+					resource.TestCheckResourceAttr("aws_ebs_snapshot_copy.test", "encrypted", "true"),
+					resource.TestMatchResourceAttr("aws_ebs_snapshot_copy.test", "kms_key_id",
+						regexp.MustCompile("^arn:aws:kms:[a-z]{2}-[a-z]+-\\d{1}:[0-9]{12}:key/[a-z0-9-]{36}$")),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckEbsSnapshotCopyDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).ec2conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_ebs_snapshot_copy" {
+			continue
+		}
+
+		resp, err := conn.DescribeSnapshots(&ec2.DescribeSnapshotsInput{
+			SnapshotIds: []*string{aws.String(rs.Primary.ID)},
+		})
+		if err != nil {
+			if isAWSErr(err, "InvalidSnapshot.NotFound", "") {
+				continue
+			}
+			return err
+		}
+
+		if len(resp.Snapshots) > 0 {
+			return fmt.Errorf("EBS snapshot %s still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccAwsEbsSnapshotCopyConfig(name string) string {
+	return fmt.Sprintf(`
+data "aws_region" "current" {}
+
+resource "aws_ebs_volume" "test" {
+  availability_zone = "us-west-2a"
+  size              = 1
+}
+
+resource "aws_ebs_snapshot" "test" {
+  volume_id = "${aws_ebs_volume.test.id}"
+
+  tags {
+    Name = "testAccAwsEbsSnapshotCopyConfig"
+  }
+}
+
+resource "aws_ebs_snapshot_copy" "test" {
+  source_snapshot_id = "${aws_ebs_snapshot.test.id}"
+  source_region      = "${data.aws_region.current.name}"
+  description        = "Copy of testAccAwsEbsSnapshotCopyConfig"
+
+  tags {
+    Name = "%s"
+  }
+}
+`, name)
+}
+
+const testAccAwsEbsSnapshotCopyConfigWithoutDescription = `
+data "aws_region" "current" {}
+
+resource "aws_ebs_volume" "test" {
+  availability_zone = "us-west-2a"
+  size              = 1
+}
+
+resource "aws_ebs_snapshot" "test" {
+  volume_id = "${aws_ebs_volume.test.id}"
+}
+
+resource "aws_ebs_snapshot_copy" "test" {
+  source_snapshot_id = "${aws_ebs_snapshot.test.id}"
+  source_region      = "${data.aws_region.current.name}"
+
+  tags {
+    Name = "testAccAwsEbsSnapshotCopyConfigWithoutDescription"
+  }
+}
+`
+
+const testAccAwsEbsSnapshotCopyConfigWithKms = `
+data "aws_region" "current" {}
+
+resource "aws_kms_key" "test" {
+  deletion_window_in_days = 7
+
+  tags {
+    Name = "testAccAwsEbsSnapshotCopyConfigWithKms"
+  }
+}
+
+resource "aws_ebs_volume" "test" {
+  availability_zone = "us-west-2a"
+  size              = 1
+}
+
+resource "aws_ebs_snapshot" "test" {
+  volume_id = "${aws_ebs_volume.test.id}"
+}
+
+resource "aws_ebs_snapshot_copy" "test" {
+  source_snapshot_id = "${aws_ebs_snapshot.test.id}"
+  source_region      = "${data.aws_region.current.name}"
+  encrypted          = true
+  kms_key_id         = "${aws_kms_key.test.arn}"
+
+  tags {
+    Name = "testAccAwsEbsSnapshotCopyConfigWithKms"
+  }
+}
+`
@@ -0,0 +1,251 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsEc2Host() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsEc2HostCreate,
+		Read:   resourceAwsEc2HostRead,
+		Update: resourceAwsEc2HostUpdate,
+		Delete: resourceAwsEc2HostDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"instance_type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"availability_zone": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"auto_placement": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  ec2.AutoPlacementOn,
+				ValidateFunc: validation.StringInSlice([]string{
+					ec2.AutoPlacementOn,
+					ec2.AutoPlacementOff,
+				}, false),
+			},
+
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"cores": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"sockets": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"total_vcpus": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"instance_ids": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+		},
+	}
+}
+
+func resourceAwsEc2HostCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	input := &ec2.AllocateHostsInput{
+		AutoPlacement:    aws.String(d.Get("auto_placement").(string)),
+		AvailabilityZone: aws.String(d.Get("availability_zone").(string)),
+		ClientToken:      aws.String(resource.UniqueId()),
+		InstanceType:     aws.String(d.Get("instance_type").(string)),
+		Quantity:         aws.Int64(1),
+	}
+
+	log.Printf("[DEBUG] Allocating EC2 Dedicated Host: %s", input)
+	out, err := conn.AllocateHosts(input)
+	if err != nil {
+		return fmt.Errorf("Error allocating EC2 Dedicated Host: %s", err)
+	}
+	if len(out.HostIds) == 0 {
+		return fmt.Errorf("Error allocating EC2 Dedicated Host: no host IDs returned")
+	}
+
+	d.SetId(*out.HostIds[0])
+	log.Printf("[INFO] EC2 Dedicated Host ID: %s", d.Id())
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"pending"},
+		Target:     []string{ec2.AllocationStateAvailable},
+		Refresh:    ec2HostStateRefreshFunc(conn, d.Id()),
+		Timeout:    5 * time.Minute,
+		MinTimeout: 3 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for EC2 Dedicated Host (%s) to become available: %s", d.Id(), err)
+	}
+
+	return resourceAwsEc2HostRead(d, meta)
+}
+
+func resourceAwsEc2HostRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	host, err := describeEc2Host(conn, d.Id())
+	if err != nil {
+		return err
+	}
+
+	if host == nil || isEc2HostReleased(host) {
+		log.Printf("[WARN] EC2 Dedicated Host (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("auto_placement", host.AutoPlacement)
+	d.Set("availability_zone", host.AvailabilityZone)
+	d.Set("state", host.State)
+
+	if props := host.HostProperties; props != nil {
+		d.Set("instance_type", props.InstanceType)
+		d.Set("cores", props.Cores)
+		d.Set("sockets", props.Sockets)
+		d.Set("total_vcpus", props.TotalVCpus)
+	}
+
+	instanceIds := make([]string, 0, len(host.Instances))
+	for _, instance := range host.Instances {
+		instanceIds = append(instanceIds, *instance.InstanceId)
+	}
+	if err := d.Set("instance_ids", instanceIds); err != nil {
+		return fmt.Errorf("Error setting instance_ids: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsEc2HostUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	if d.HasChange("auto_placement") {
+		input := &ec2.ModifyHostsInput{
+			AutoPlacement: aws.String(d.Get("auto_placement").(string)),
+			HostIds:       []*string{aws.String(d.Id())},
+		}
+
+		log.Printf("[DEBUG] Modifying EC2 Dedicated Host: %s", input)
+		out, err := conn.ModifyHosts(input)
+		if err != nil {
+			return fmt.Errorf("Error modifying EC2 Dedicated Host (%s): %s", d.Id(), err)
+		}
+		if err := ec2HostUnsuccessfulItemsError(out.Unsuccessful); err != nil {
+			return fmt.Errorf("Error modifying EC2 Dedicated Host (%s): %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsEc2HostRead(d, meta)
+}
+
+func resourceAwsEc2HostDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	log.Printf("[DEBUG] Releasing EC2 Dedicated Host: %s", d.Id())
+	out, err := conn.ReleaseHosts(&ec2.ReleaseHostsInput{
+		HostIds: []*string{aws.String(d.Id())},
+	})
+	if err != nil {
+		return fmt.Errorf("Error releasing EC2 Dedicated Host (%s): %s", d.Id(), err)
+	}
+	// A host with running instances cannot be released; the API reports this
+	// per host rather than as a request error.
+	if err := ec2HostUnsuccessfulItemsError(out.Unsuccessful); err != nil {
+		return fmt.Errorf("Error releasing EC2 Dedicated Host (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func describeEc2Host(conn *ec2.EC2, id string) (*ec2.Host, error) {
+	out, err := conn.DescribeHosts(&ec2.DescribeHostsInput{
+		HostIds: []*string{aws.String(id)},
+	})
+	if err != nil {
+		if isAWSErr(err, "InvalidHostID.NotFound", "") {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	for _, host := range out.Hosts {
+		if aws.StringValue(host.HostId) == id {
+			return host, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func isEc2HostReleased(host *ec2.Host) bool {
+	switch aws.StringValue(host.State) {
+	case ec2.AllocationStateReleased, ec2.AllocationStateReleasedPermanentFailure:
+		return true
+	}
+	return false
+}
+
+// ec2HostStateRefreshFunc returns a resource.StateRefreshFunc that is used
+// to watch the allocation state of an EC2 Dedicated Host.
+func ec2HostStateRefreshFunc(conn *ec2.EC2, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		host, err := describeEc2Host(conn, id)
+		if err != nil {
+			return nil, "", err
+		}
+
+		if host == nil {
+			// Eventual consistency; the host may not be visible yet.
+			return nil, "pending", nil
+		}
+
+		state := aws.StringValue(host.State)
+		if state == ec2.AllocationStatePermanentFailure || isEc2HostReleased(host) {
+			return host, state, fmt.Errorf("unexpected host state %q", state)
+		}
+
+		return host, state, nil
+	}
+}
+
+func ec2HostUnsuccessfulItemsError(items []*ec2.UnsuccessfulItem) error {
+	for _, item := range items {
+		if item.Error != nil {
+			return fmt.Errorf("%s: %s", aws.StringValue(item.Error.Code), aws.StringValue(item.Error.Message))
+		}
+	}
+	return nil
+}
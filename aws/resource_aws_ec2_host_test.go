@@ -0,0 +1,103 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSEc2Host_basic(t *testing.T) {
+	var host ec2.Host
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSEc2HostDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSEc2HostConfig("on"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEc2HostExists("aws_ec2_host.test", &host),
+					resource.TestCheckResourceAttr("aws_ec2_host.test", "instance_type", "m4.large"),
+					resource.TestCheckResourceAttr("aws_ec2_host.test", "auto_placement", "on"),
+					resource.TestCheckResourceAttr("aws_ec2_host.test", "state", "available"),
+					resource.TestCheckResourceAttr("aws_ec2_host.test", "instance_ids.#", "0"),
+				),
+			},
+			{
+				Config: testAccAWSEc2HostConfig("off"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEc2HostExists("aws_ec2_host.test", &host),
+					resource.TestCheckResourceAttr("aws_ec2_host.test", "auto_placement", "off"),
+				),
+			},
+			{
+				ResourceName:      "aws_ec2_host.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSEc2HostDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).ec2conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_ec2_host" {
+			continue
+		}
+
+		host, err := describeEc2Host(conn, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if host != nil && !isEc2HostReleased(host) {
+			return fmt.Errorf("EC2 Dedicated Host %q still allocated", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAWSEc2HostExists(n string, host *ec2.Host) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No EC2 Dedicated Host ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+		h, err := describeEc2Host(conn, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if h == nil || isEc2HostReleased(h) {
+			return fmt.Errorf("EC2 Dedicated Host %q not found", rs.Primary.ID)
+		}
+
+		*host = *h
+		return nil
+	}
+}
+
+func testAccAWSEc2HostConfig(autoPlacement string) string {
+	return fmt.Sprintf(`
+data "aws_availability_zones" "available" {}
+
+resource "aws_ec2_host" "test" {
+  instance_type     = "m4.large"
+  availability_zone = "${data.aws_availability_zones.available.names[0]}"
+  auto_placement    = "%s"
+}
+`, autoPlacement)
+}
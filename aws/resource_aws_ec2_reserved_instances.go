@@ -0,0 +1,347 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsEc2ReservedInstances() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsEc2ReservedInstancesCreate,
+		Read:   resourceAwsEc2ReservedInstancesRead,
+		Update: resourceAwsEc2ReservedInstancesUpdate,
+		Delete: resourceAwsEc2ReservedInstancesDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"instance_type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			// Reservations without an availability zone are regional.
+			"availability_zone": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"instance_tenancy": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  ec2.TenancyDefault,
+				ValidateFunc: validation.StringInSlice([]string{
+					ec2.TenancyDefault,
+					ec2.TenancyDedicated,
+				}, false),
+			},
+
+			"offering_class": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  ec2.OfferingClassTypeStandard,
+				ValidateFunc: validation.StringInSlice([]string{
+					ec2.OfferingClassTypeStandard,
+					ec2.OfferingClassTypeConvertible,
+				}, false),
+			},
+
+			"offering_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  ec2.OfferingTypeValuesNoUpfront,
+				ValidateFunc: validation.StringInSlice([]string{
+					ec2.OfferingTypeValuesNoUpfront,
+					ec2.OfferingTypeValuesPartialUpfront,
+					ec2.OfferingTypeValuesAllUpfront,
+				}, false),
+			},
+
+			"product_description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  ec2.RIProductDescriptionLinuxUnix,
+			},
+
+			"duration": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateEc2ReservedInstancesDuration,
+			},
+
+			"instance_count": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      1,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+
+			"offering_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"scope": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"start": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"end": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"fixed_price": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+
+			"usage_price": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+
+			"currency_code": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceAwsEc2ReservedInstancesCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	duration := int64(d.Get("duration").(int))
+	input := &ec2.DescribeReservedInstancesOfferingsInput{
+		IncludeMarketplace: aws.Bool(false),
+		InstanceTenancy:    aws.String(d.Get("instance_tenancy").(string)),
+		InstanceType:       aws.String(d.Get("instance_type").(string)),
+		MaxDuration:        aws.Int64(duration),
+		MinDuration:        aws.Int64(duration),
+		OfferingClass:      aws.String(d.Get("offering_class").(string)),
+		OfferingType:       aws.String(d.Get("offering_type").(string)),
+		ProductDescription: aws.String(d.Get("product_description").(string)),
+	}
+
+	scope := ec2.ScopeRegion
+	if v, ok := d.GetOk("availability_zone"); ok {
+		input.AvailabilityZone = aws.String(v.(string))
+		scope = ec2.ScopeAvailabilityZone
+	}
+	input.Filters = buildEC2AttributeFilterList(map[string]string{
+		"scope": scope,
+	})
+
+	offerings, err := describeEc2ReservedInstancesOfferings(conn, input)
+	if err != nil {
+		return fmt.Errorf("Error describing EC2 Reserved Instances offerings: %s", err)
+	}
+	if len(offerings) == 0 {
+		return fmt.Errorf("No EC2 Reserved Instances offering matched: %s", input)
+	}
+	if len(offerings) > 1 {
+		return fmt.Errorf("%d EC2 Reserved Instances offerings matched, expected 1: %s", len(offerings), input)
+	}
+	offeringId := aws.StringValue(offerings[0].ReservedInstancesOfferingId)
+
+	purchase := &ec2.PurchaseReservedInstancesOfferingInput{
+		InstanceCount:               aws.Int64(int64(d.Get("instance_count").(int))),
+		ReservedInstancesOfferingId: aws.String(offeringId),
+	}
+
+	log.Printf("[DEBUG] Purchasing EC2 Reserved Instances: %s", purchase)
+	out, err := conn.PurchaseReservedInstancesOffering(purchase)
+	if err != nil {
+		return fmt.Errorf("Error purchasing EC2 Reserved Instances offering (%s): %s", offeringId, err)
+	}
+
+	d.SetId(aws.StringValue(out.ReservedInstancesId))
+	log.Printf("[INFO] EC2 Reserved Instances ID: %s", d.Id())
+
+	// The offering isn't reported by DescribeReservedInstances.
+	d.Set("offering_id", offeringId)
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ec2.ReservedInstanceStatePaymentPending},
+		Target: []string{
+			ec2.ReservedInstanceStateActive,
+			ec2.ReservedInstanceStatePaymentFailed,
+		},
+		Refresh:    ec2ReservedInstancesStateRefreshFunc(conn, d.Id()),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	// The purchase has already been made, so only a failed payment fails the
+	// create. Anything else (e.g. payment still pending after the timeout) keeps
+	// the reservation in state, otherwise the next apply would buy another one.
+	ri, err := stateConf.WaitForState()
+	if err != nil {
+		log.Printf("[WARN] Error waiting for EC2 Reserved Instances (%s) to become active: %s", d.Id(), err)
+	} else if aws.StringValue(ri.(*ec2.ReservedInstances).State) == ec2.ReservedInstanceStatePaymentFailed {
+		return fmt.Errorf("Payment for EC2 Reserved Instances (%s) failed", d.Id())
+	}
+
+	if err := setTags(conn, d); err != nil {
+		return err
+	}
+
+	return resourceAwsEc2ReservedInstancesRead(d, meta)
+}
+
+func resourceAwsEc2ReservedInstancesRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	ri, err := describeEc2ReservedInstances(conn, d.Id())
+	if err != nil {
+		return err
+	}
+
+	// Retired reservations are still described and so stay in state; only a
+	// reservation which is no longer reported at all is removed.
+	if ri == nil {
+		log.Printf("[WARN] EC2 Reserved Instances (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("instance_type", ri.InstanceType)
+	d.Set("instance_tenancy", ri.InstanceTenancy)
+	d.Set("offering_class", ri.OfferingClass)
+	d.Set("offering_type", ri.OfferingType)
+	d.Set("product_description", ri.ProductDescription)
+	d.Set("duration", ri.Duration)
+	d.Set("instance_count", ri.InstanceCount)
+	d.Set("scope", ri.Scope)
+	d.Set("state", ri.State)
+	d.Set("fixed_price", ri.FixedPrice)
+	d.Set("usage_price", ri.UsagePrice)
+	d.Set("currency_code", ri.CurrencyCode)
+
+	if aws.StringValue(ri.Scope) == ec2.ScopeAvailabilityZone {
+		d.Set("availability_zone", ri.AvailabilityZone)
+	} else {
+		d.Set("availability_zone", "")
+	}
+
+	if ri.Start != nil {
+		d.Set("start", aws.TimeValue(ri.Start).Format(time.RFC3339))
+	}
+	if ri.End != nil {
+		d.Set("end", aws.TimeValue(ri.End).Format(time.RFC3339))
+	}
+
+	if err := d.Set("tags", tagsToMap(ri.Tags)); err != nil {
+		return fmt.Errorf("Error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsEc2ReservedInstancesUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	if err := setTags(conn, d); err != nil {
+		return err
+	}
+
+	return resourceAwsEc2ReservedInstancesRead(d, meta)
+}
+
+func resourceAwsEc2ReservedInstancesDelete(d *schema.ResourceData, meta interface{}) error {
+	// A reservation is a billing commitment which can't be cancelled through
+	// the API, so all we can do is forget about it.
+	log.Printf("[WARN] EC2 Reserved Instances (%s) cannot be cancelled and will remain active until %s; "+
+		"removing from state only", d.Id(), d.Get("end").(string))
+
+	return nil
+}
+
+func describeEc2ReservedInstances(conn *ec2.EC2, id string) (*ec2.ReservedInstances, error) {
+	out, err := conn.DescribeReservedInstances(&ec2.DescribeReservedInstancesInput{
+		ReservedInstancesIds: []*string{aws.String(id)},
+	})
+	if err != nil {
+		if isAWSErr(err, "InvalidReservedInstancesId.NotFound", "") {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("Error describing EC2 Reserved Instances (%s): %s", id, err)
+	}
+
+	for _, ri := range out.ReservedInstances {
+		if aws.StringValue(ri.ReservedInstancesId) == id {
+			return ri, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// describeEc2ReservedInstancesOfferings returns every offering matching the
+// given input, following pagination.
+func describeEc2ReservedInstancesOfferings(conn *ec2.EC2, input *ec2.DescribeReservedInstancesOfferingsInput) ([]*ec2.ReservedInstancesOffering, error) {
+	var offerings []*ec2.ReservedInstancesOffering
+
+	log.Printf("[DEBUG] Describing EC2 Reserved Instances offerings: %s", input)
+	err := conn.DescribeReservedInstancesOfferingsPages(input, func(page *ec2.DescribeReservedInstancesOfferingsOutput, lastPage bool) bool {
+		offerings = append(offerings, page.ReservedInstancesOfferings...)
+		return !lastPage
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return offerings, nil
+}
+
+// ec2ReservedInstancesStateRefreshFunc returns a resource.StateRefreshFunc
+// that is used to watch the payment state of EC2 Reserved Instances.
+func ec2ReservedInstancesStateRefreshFunc(conn *ec2.EC2, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		ri, err := describeEc2ReservedInstances(conn, id)
+		if err != nil {
+			return nil, "", err
+		}
+
+		if ri == nil {
+			// Eventual consistency; the purchase may not be visible yet.
+			return nil, ec2.ReservedInstanceStatePaymentPending, nil
+		}
+
+		return ri, aws.StringValue(ri.State), nil
+	}
+}
@@ -0,0 +1,88 @@
+package aws
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSEc2ReservedInstances_basic(t *testing.T) {
+	// Purchasing a reservation is a real, non-refundable billing commitment
+	// which outlives the test, so it has to be explicitly opted into.
+	if os.Getenv("AWS_EC2_RESERVED_INSTANCES_PURCHASE") == "" {
+		t.Skip("Environment variable AWS_EC2_RESERVED_INSTANCES_PURCHASE is not set")
+	}
+
+	var ri ec2.ReservedInstances
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSEc2ReservedInstancesConfig("foo"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEc2ReservedInstancesExists("aws_ec2_reserved_instances.test", &ri),
+					resource.TestCheckResourceAttr("aws_ec2_reserved_instances.test", "instance_type", "t2.nano"),
+					resource.TestCheckResourceAttr("aws_ec2_reserved_instances.test", "scope", "Region"),
+					resource.TestCheckResourceAttr("aws_ec2_reserved_instances.test", "state", "active"),
+					resource.TestCheckResourceAttrSet("aws_ec2_reserved_instances.test", "offering_id"),
+					resource.TestCheckResourceAttrSet("aws_ec2_reserved_instances.test", "end"),
+					resource.TestCheckResourceAttr("aws_ec2_reserved_instances.test", "tags.Name", "foo"),
+				),
+			},
+			{
+				Config: testAccAWSEc2ReservedInstancesConfig("bar"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEc2ReservedInstancesExists("aws_ec2_reserved_instances.test", &ri),
+					resource.TestCheckResourceAttr("aws_ec2_reserved_instances.test", "tags.Name", "bar"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSEc2ReservedInstancesExists(n string, ri *ec2.ReservedInstances) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No EC2 Reserved Instances ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+		r, err := describeEc2ReservedInstances(conn, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if r == nil {
+			return fmt.Errorf("EC2 Reserved Instances %q not found", rs.Primary.ID)
+		}
+
+		*ri = *r
+
+		return nil
+	}
+}
+
+func testAccAWSEc2ReservedInstancesConfig(name string) string {
+	return fmt.Sprintf(`
+resource "aws_ec2_reserved_instances" "test" {
+  instance_type  = "t2.nano"
+  duration       = 31536000
+  offering_type  = "No Upfront"
+  instance_count = 1
+
+  tags {
+    Name = "%s"
+  }
+}
+`, name)
+}
@@ -17,6 +17,7 @@ import (
 	"github.com/hashicorp/terraform/helper/hashcode"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 )
 
 func resourceAwsInstance() *schema.Resource {
@@ -261,6 +262,22 @@ func resourceAwsInstance() *schema.Resource {
 				ForceNew: true,
 			},
 
+			"host_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"affinity": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					ec2.AffinityDefault,
+					ec2.AffinityHost,
+				}, false),
+			},
+
 			"tags": tagsSchema(),
 
 			"volume_tags": tagsSchemaComputed(),
@@ -629,6 +646,8 @@ func resourceAwsInstanceRead(d *schema.ResourceData, meta interface{}) error {
 	if instance.Placement.Tenancy != nil {
 		d.Set("tenancy", instance.Placement.Tenancy)
 	}
+	d.Set("host_id", instance.Placement.HostId)
+	d.Set("affinity", instance.Placement.Affinity)
 
 	d.Set("ami", instance.ImageId)
 	d.Set("instance_type", instance.InstanceType)
@@ -803,81 +822,9 @@ func resourceAwsInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	if d.HasChange("iam_instance_profile") && !d.IsNewResource() {
-		request := &ec2.DescribeIamInstanceProfileAssociationsInput{
-			Filters: []*ec2.Filter{
-				{
-					Name:   aws.String("instance-id"),
-					Values: []*string{aws.String(d.Id())},
-				},
-			},
-		}
-
-		resp, err := conn.DescribeIamInstanceProfileAssociations(request)
-		if err != nil {
+		if err := updateInstanceIamInstanceProfile(conn, d); err != nil {
 			return err
 		}
-
-		// An Iam Instance Profile has been provided and is pending a change
-		// This means it is an association or a replacement to an association
-		if _, ok := d.GetOk("iam_instance_profile"); ok {
-			// Does not have an Iam Instance Profile associated with it, need to associate
-			if len(resp.IamInstanceProfileAssociations) == 0 {
-				err := resource.Retry(1*time.Minute, func() *resource.RetryError {
-					_, err := conn.AssociateIamInstanceProfile(&ec2.AssociateIamInstanceProfileInput{
-						InstanceId: aws.String(d.Id()),
-						IamInstanceProfile: &ec2.IamInstanceProfileSpecification{
-							Name: aws.String(d.Get("iam_instance_profile").(string)),
-						},
-					})
-					if err != nil {
-						if isAWSErr(err, "InvalidParameterValue", "Invalid IAM Instance Profile") {
-							return resource.RetryableError(err)
-						}
-						return resource.NonRetryableError(err)
-					}
-					return nil
-				})
-				if err != nil {
-					return err
-				}
-
-			} else {
-				// Has an Iam Instance Profile associated with it, need to replace the association
-				associationId := resp.IamInstanceProfileAssociations[0].AssociationId
-
-				err := resource.Retry(1*time.Minute, func() *resource.RetryError {
-					_, err := conn.ReplaceIamInstanceProfileAssociation(&ec2.ReplaceIamInstanceProfileAssociationInput{
-						AssociationId: associationId,
-						IamInstanceProfile: &ec2.IamInstanceProfileSpecification{
-							Name: aws.String(d.Get("iam_instance_profile").(string)),
-						},
-					})
-					if err != nil {
-						if isAWSErr(err, "InvalidParameterValue", "Invalid IAM Instance Profile") {
-							return resource.RetryableError(err)
-						}
-						return resource.NonRetryableError(err)
-					}
-					return nil
-				})
-				if err != nil {
-					return err
-				}
-			}
-			// An Iam Instance Profile has _not_ been provided but is pending a change. This means there is a pending removal
-		} else {
-			if len(resp.IamInstanceProfileAssociations) > 0 {
-				// Has an Iam Instance Profile associated with it, need to remove the association
-				associationId := resp.IamInstanceProfileAssociations[0].AssociationId
-
-				_, err := conn.DisassociateIamInstanceProfile(&ec2.DisassociateIamInstanceProfileInput{
-					AssociationId: associationId,
-				})
-				if err != nil {
-					return err
-				}
-			}
-		}
 	}
 
 	// SourceDestCheck can only be modified on an instance without manually specified network interfaces.
@@ -956,8 +903,13 @@ func resourceAwsInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
-	if d.HasChange("instance_type") && !d.IsNewResource() {
-		log.Printf("[INFO] Stopping Instance %q for instance_type change", d.Id())
+	instanceTypeChanged := d.HasChange("instance_type") && !d.IsNewResource()
+	placementChanged := (d.HasChange("host_id") || d.HasChange("affinity")) && !d.IsNewResource()
+
+	// Both the instance type and the host placement can only be modified
+	// while the instance is stopped, so handle them with a single restart.
+	if instanceTypeChanged || placementChanged {
+		log.Printf("[INFO] Stopping Instance %q for instance_type or placement change", d.Id())
 		_, err := conn.StopInstances(&ec2.StopInstancesInput{
 			InstanceIds: []*string{aws.String(d.Id())},
 		})
@@ -977,18 +929,39 @@ func resourceAwsInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 				"Error waiting for instance (%s) to stop: %s", d.Id(), err)
 		}
 
-		log.Printf("[INFO] Modifying instance type %s", d.Id())
-		_, err = conn.ModifyInstanceAttribute(&ec2.ModifyInstanceAttributeInput{
-			InstanceId: aws.String(d.Id()),
-			InstanceType: &ec2.AttributeValue{
-				Value: aws.String(d.Get("instance_type").(string)),
-			},
-		})
-		if err != nil {
-			return err
+		if instanceTypeChanged {
+			log.Printf("[INFO] Modifying instance type %s", d.Id())
+			_, err = conn.ModifyInstanceAttribute(&ec2.ModifyInstanceAttributeInput{
+				InstanceId: aws.String(d.Id()),
+				InstanceType: &ec2.AttributeValue{
+					Value: aws.String(d.Get("instance_type").(string)),
+				},
+			})
+			if err != nil {
+				return err
+			}
 		}
 
-		log.Printf("[INFO] Starting Instance %q after instance_type change", d.Id())
+		if placementChanged {
+			input := &ec2.ModifyInstancePlacementInput{
+				InstanceId: aws.String(d.Id()),
+			}
+			if v := d.Get("host_id").(string); v != "" {
+				input.HostId = aws.String(v)
+				input.Tenancy = aws.String(ec2.HostTenancyHost)
+			}
+			if v := d.Get("affinity").(string); v != "" {
+				input.Affinity = aws.String(v)
+			}
+
+			log.Printf("[INFO] Modifying instance placement %s: %s", d.Id(), input)
+			_, err = conn.ModifyInstancePlacement(input)
+			if err != nil {
+				return fmt.Errorf("Error modifying placement of instance (%s): %s", d.Id(), err)
+			}
+		}
+
+		log.Printf("[INFO] Starting Instance %q after instance_type or placement change", d.Id())
 		_, err = conn.StartInstances(&ec2.StartInstancesInput{
 			InstanceIds: []*string{aws.String(d.Id())},
 		})
@@ -1628,6 +1601,19 @@ func buildAwsInstanceOpts(
 		opts.Placement.Tenancy = aws.String(v)
 	}
 
+	// host_id and affinity are not part of the aws_spot_instance_request
+	// schema, hence GetOk rather than Get.
+	if v, ok := d.GetOk("host_id"); ok {
+		opts.Placement.HostId = aws.String(v.(string))
+		// Instances can only be launched onto a Dedicated Host with host tenancy.
+		if opts.Placement.Tenancy == nil {
+			opts.Placement.Tenancy = aws.String(ec2.TenancyHost)
+		}
+	}
+	if v, ok := d.GetOk("affinity"); ok {
+		opts.Placement.Affinity = aws.String(v.(string))
+	}
+
 	var groups []*string
 	if v := d.Get("security_groups"); v != nil {
 		// Security group names.
@@ -1732,6 +1718,166 @@ func awsTerminateInstance(conn *ec2.EC2, id string, d *schema.ResourceData) erro
 	return nil
 }
 
+// updateInstanceIamInstanceProfile associates, replaces or removes the IAM
+// instance profile of a running instance to match the configuration, waiting
+// for the change to take effect.
+func updateInstanceIamInstanceProfile(conn *ec2.EC2, d *schema.ResourceData) error {
+	association, err := describeInstanceIamInstanceProfileAssociation(conn, d.Id())
+	if err != nil {
+		return err
+	}
+
+	name := d.Get("iam_instance_profile").(string)
+
+	// An Iam Instance Profile has _not_ been provided but is pending a change. This means there is a pending removal
+	if name == "" {
+		if association == nil {
+			return nil
+		}
+
+		log.Printf("[INFO] Disassociating IAM Instance Profile %s from Instance %s", *association.AssociationId, d.Id())
+		_, err := conn.DisassociateIamInstanceProfile(&ec2.DisassociateIamInstanceProfileInput{
+			AssociationId: association.AssociationId,
+		})
+		if err != nil {
+			return fmt.Errorf("Error disassociating IAM Instance Profile from Instance (%s): %s", d.Id(), err)
+		}
+
+		return waitForInstanceIamInstanceProfileAssociation(conn, *association.AssociationId,
+			ec2.IamInstanceProfileAssociationStateDisassociated, d.Timeout(schema.TimeoutUpdate))
+	}
+
+	profile := &ec2.IamInstanceProfileSpecification{
+		Name: aws.String(name),
+	}
+
+	var associationId *string
+	err = resource.Retry(1*time.Minute, func() *resource.RetryError {
+		var err error
+		if association == nil {
+			// Does not have an Iam Instance Profile associated with it, need to associate
+			log.Printf("[INFO] Associating IAM Instance Profile %s with Instance %s", name, d.Id())
+			var resp *ec2.AssociateIamInstanceProfileOutput
+			resp, err = conn.AssociateIamInstanceProfile(&ec2.AssociateIamInstanceProfileInput{
+				InstanceId:         aws.String(d.Id()),
+				IamInstanceProfile: profile,
+			})
+			if err == nil {
+				associationId = resp.IamInstanceProfileAssociation.AssociationId
+			}
+		} else {
+			// Has an Iam Instance Profile associated with it, need to replace the association
+			log.Printf("[INFO] Replacing IAM Instance Profile association %s on Instance %s with %s",
+				*association.AssociationId, d.Id(), name)
+			var resp *ec2.ReplaceIamInstanceProfileAssociationOutput
+			resp, err = conn.ReplaceIamInstanceProfileAssociation(&ec2.ReplaceIamInstanceProfileAssociationInput{
+				AssociationId:      association.AssociationId,
+				IamInstanceProfile: profile,
+			})
+			if err == nil {
+				associationId = resp.IamInstanceProfileAssociation.AssociationId
+			}
+		}
+		if err != nil {
+			// IAM instance profiles can take ~10 seconds to propagate in AWS
+			if isAWSErr(err, "InvalidParameterValue", "Invalid IAM Instance Profile") {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("Error updating IAM Instance Profile of Instance (%s): %s", d.Id(), err)
+	}
+
+	return waitForInstanceIamInstanceProfileAssociation(conn, *associationId,
+		ec2.IamInstanceProfileAssociationStateAssociated, d.Timeout(schema.TimeoutUpdate))
+}
+
+// describeInstanceIamInstanceProfileAssociation returns the current IAM
+// instance profile association of an instance, or nil if there is none.
+func describeInstanceIamInstanceProfileAssociation(conn *ec2.EC2, instanceId string) (*ec2.IamInstanceProfileAssociation, error) {
+	resp, err := conn.DescribeIamInstanceProfileAssociations(&ec2.DescribeIamInstanceProfileAssociationsInput{
+		Filters: []*ec2.Filter{
+			{
+				Name:   aws.String("instance-id"),
+				Values: []*string{aws.String(instanceId)},
+			},
+			{
+				Name: aws.String("state"),
+				Values: []*string{
+					aws.String(ec2.IamInstanceProfileAssociationStateAssociating),
+					aws.String(ec2.IamInstanceProfileAssociationStateAssociated),
+				},
+			},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(resp.IamInstanceProfileAssociations) == 0 {
+		return nil, nil
+	}
+
+	return resp.IamInstanceProfileAssociations[0], nil
+}
+
+func waitForInstanceIamInstanceProfileAssociation(conn *ec2.EC2, associationId, target string, timeout time.Duration) error {
+	log.Printf("[DEBUG] Waiting for IAM Instance Profile association %s to become %s", associationId, target)
+
+	pending := []string{
+		ec2.IamInstanceProfileAssociationStateAssociating,
+		ec2.IamInstanceProfileAssociationStateDisassociating,
+	}
+	if target == ec2.IamInstanceProfileAssociationStateDisassociated {
+		// The association may briefly still be reported as associated.
+		pending = append(pending, ec2.IamInstanceProfileAssociationStateAssociated)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    pending,
+		Target:     []string{target},
+		Refresh:    InstanceIamInstanceProfileAssociationStateRefreshFunc(conn, associationId),
+		Timeout:    timeout,
+		Delay:      2 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for IAM Instance Profile association (%s) to become %s: %s",
+			associationId, target, err)
+	}
+
+	return nil
+}
+
+// InstanceIamInstanceProfileAssociationStateRefreshFunc returns a
+// resource.StateRefreshFunc that is used to watch an IAM instance profile
+// association. Associations that can no longer be found are reported as
+// disassociated.
+func InstanceIamInstanceProfileAssociationStateRefreshFunc(conn *ec2.EC2, associationId string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := conn.DescribeIamInstanceProfileAssociations(&ec2.DescribeIamInstanceProfileAssociationsInput{
+			AssociationIds: []*string{aws.String(associationId)},
+		})
+		if err != nil {
+			if isAWSErr(err, "InvalidAssociationID.NotFound", "") {
+				return associationId, ec2.IamInstanceProfileAssociationStateDisassociated, nil
+			}
+			return nil, "", err
+		}
+
+		if len(resp.IamInstanceProfileAssociations) == 0 {
+			return associationId, ec2.IamInstanceProfileAssociationStateDisassociated, nil
+		}
+
+		association := resp.IamInstanceProfileAssociations[0]
+		return association, *association.State, nil
+	}
+}
+
 func iamInstanceProfileArnToName(ip *ec2.IamInstanceProfile) string {
 	if ip == nil || ip.Arn == nil {
 		return ""
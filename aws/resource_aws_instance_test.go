@@ -809,15 +809,29 @@ func TestAccAWSInstance_volumeTagsComputed(t *testing.T) {
 }
 
 func TestAccAWSInstance_instanceProfileChange(t *testing.T) {
-	var v ec2.Instance
+	var v, before ec2.Instance
 	rName := acctest.RandString(5)
 
-	testCheckInstanceProfile := func() resource.TestCheckFunc {
+	testCheckInstanceProfile := func(name string) resource.TestCheckFunc {
 		return func(*terraform.State) error {
 			if v.IamInstanceProfile == nil {
 				return fmt.Errorf("Instance Profile is nil - we expected an InstanceProfile associated with the Instance")
 			}
 
+			if actual := iamInstanceProfileArnToName(v.IamInstanceProfile); actual != name {
+				return fmt.Errorf("Expected Instance Profile %q, got %q", name, actual)
+			}
+
+			return nil
+		}
+	}
+
+	testCheckNoInstanceProfile := func() resource.TestCheckFunc {
+		return func(*terraform.State) error {
+			if v.IamInstanceProfile != nil {
+				return fmt.Errorf("Expected no Instance Profile, got %q", *v.IamInstanceProfile.Arn)
+			}
+
 			return nil
 		}
 	}
@@ -831,14 +845,32 @@ func TestAccAWSInstance_instanceProfileChange(t *testing.T) {
 			{
 				Config: testAccInstanceConfigWithoutInstanceProfile(rName),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckInstanceExists("aws_instance.foo", &v),
+					testAccCheckInstanceExists("aws_instance.foo", &before),
 				),
 			},
 			{
 				Config: testAccInstanceConfigWithInstanceProfile(rName),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckInstanceExists("aws_instance.foo", &v),
-					testCheckInstanceProfile(),
+					testAccCheckInstanceNotRecreated(t, &before, &v),
+					testCheckInstanceProfile("test-"+rName),
+				),
+			},
+			{
+				Config: testAccInstanceConfigWithReplacementInstanceProfile(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists("aws_instance.foo", &v),
+					testAccCheckInstanceNotRecreated(t, &before, &v),
+					testCheckInstanceProfile("test-replacement-"+rName),
+				),
+			},
+			{
+				Config: testAccInstanceConfigWithoutInstanceProfile(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists("aws_instance.foo", &v),
+					testAccCheckInstanceNotRecreated(t, &before, &v),
+					testCheckNoInstanceProfile(),
+					resource.TestCheckResourceAttr("aws_instance.foo", "iam_instance_profile", ""),
 				),
 			},
 		},
@@ -1058,6 +1090,36 @@ func TestAccAWSInstance_changeInstanceType(t *testing.T) {
 	})
 }
 
+func TestAccAWSInstance_dedicatedHost(t *testing.T) {
+	var before ec2.Instance
+	var after ec2.Instance
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceConfigDedicatedHost("a"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists("aws_instance.foo", &before),
+					resource.TestCheckResourceAttr("aws_instance.foo", "tenancy", "host"),
+					resource.TestCheckResourceAttr("aws_instance.foo", "affinity", "host"),
+					resource.TestCheckResourceAttrPair("aws_instance.foo", "host_id", "aws_ec2_host.a", "id"),
+				),
+			},
+			{
+				Config: testAccInstanceConfigDedicatedHost("b"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists("aws_instance.foo", &after),
+					testAccCheckInstanceNotRecreated(t, &before, &after),
+					resource.TestCheckResourceAttrPair("aws_instance.foo", "host_id", "aws_ec2_host.b", "id"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAWSInstance_primaryNetworkInterface(t *testing.T) {
 	var instance ec2.Instance
 	var ini ec2.NetworkInterface
@@ -1506,6 +1568,36 @@ resource "aws_instance" "foo" {
 }
 `
 
+func testAccInstanceConfigDedicatedHost(host string) string {
+	return fmt.Sprintf(`
+resource "aws_ec2_host" "a" {
+	instance_type = "m4.large"
+	availability_zone = "us-west-2a"
+	auto_placement = "off"
+}
+
+resource "aws_ec2_host" "b" {
+	instance_type = "m4.large"
+	availability_zone = "us-west-2a"
+	auto_placement = "off"
+}
+
+resource "aws_instance" "foo" {
+	# us-west-2
+	ami = "ami-55a7ea65"
+	availability_zone = "us-west-2a"
+	instance_type = "m4.large"
+
+	host_id = "${aws_ec2_host.%s.id}"
+	affinity = "host"
+
+	tags {
+	    Name = "tf-acctest"
+	}
+}
+`, host)
+}
+
 const testAccInstanceGP2IopsDevice = `
 resource "aws_instance" "foo" {
 	# us-west-2
@@ -2058,6 +2150,33 @@ resource "aws_instance" "foo" {
 }`, rName, rName)
 }
 
+func testAccInstanceConfigWithReplacementInstanceProfile(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_iam_role" "test" {
+	name = "test-%s"
+	assume_role_policy = "{\"Version\":\"2012-10-17\",\"Statement\":[{\"Effect\":\"Allow\",\"Principal\":{\"Service\":[\"ec2.amazonaws.com\"]},\"Action\":[\"sts:AssumeRole\"]}]}"
+}
+
+resource "aws_iam_instance_profile" "test" {
+	name = "test-%s"
+	roles = ["${aws_iam_role.test.name}"]
+}
+
+resource "aws_iam_instance_profile" "replacement" {
+	name = "test-replacement-%s"
+	roles = ["${aws_iam_role.test.name}"]
+}
+
+resource "aws_instance" "foo" {
+	ami = "ami-4fccb37f"
+	instance_type = "m1.small"
+	iam_instance_profile = "${aws_iam_instance_profile.replacement.name}"
+	tags {
+		bar = "baz"
+	}
+}`, rName, rName, rName)
+}
+
 const testAccInstanceConfigPrivateIP = `
 resource "aws_vpc" "foo" {
 	cidr_block = "10.1.0.0/16"
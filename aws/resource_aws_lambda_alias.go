@@ -39,6 +39,20 @@ func resourceAwsLambdaAlias() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"routing_config": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"additional_version_weights": &schema.Schema{
+							Type:     schema.TypeMap,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeFloat},
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -58,6 +72,7 @@ func resourceAwsLambdaAliasCreate(d *schema.ResourceData, meta interface{}) erro
 		FunctionName:    aws.String(functionName),
 		FunctionVersion: aws.String(d.Get("function_version").(string)),
 		Name:            aws.String(aliasName),
+		RoutingConfig:   expandLambdaAliasRoutingConfiguration(d.Get("routing_config").([]interface{})),
 	}
 
 	aliasConfiguration, err := conn.CreateAlias(params)
@@ -98,6 +113,10 @@ func resourceAwsLambdaAliasRead(d *schema.ResourceData, meta interface{}) error
 	d.Set("name", aliasConfiguration.Name)
 	d.Set("arn", aliasConfiguration.AliasArn)
 
+	if err := d.Set("routing_config", flattenLambdaAliasRoutingConfiguration(aliasConfiguration.RoutingConfig)); err != nil {
+		return fmt.Errorf("Error setting routing_config: %s", err)
+	}
+
 	return nil
 }
 
@@ -137,10 +156,55 @@ func resourceAwsLambdaAliasUpdate(d *schema.ResourceData, meta interface{}) erro
 		Name:            aws.String(d.Get("name").(string)),
 	}
 
+	if d.HasChange("routing_config") {
+		params.RoutingConfig = expandLambdaAliasRoutingConfiguration(d.Get("routing_config").([]interface{}))
+		if params.RoutingConfig == nil {
+			// An empty set of weights sends all traffic to function_version.
+			params.RoutingConfig = &lambda.AliasRoutingConfiguration{
+				AdditionalVersionWeights: map[string]*float64{},
+			}
+		}
+	}
+
 	_, err := conn.UpdateAlias(params)
 	if err != nil {
 		return fmt.Errorf("Error updating Lambda alias: %s", err)
 	}
 
-	return nil
+	return resourceAwsLambdaAliasRead(d, meta)
+}
+
+func expandLambdaAliasRoutingConfiguration(l []interface{}) *lambda.AliasRoutingConfiguration {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+	weights := make(map[string]*float64)
+	if v, ok := m["additional_version_weights"]; ok {
+		for version, weight := range v.(map[string]interface{}) {
+			weights[version] = aws.Float64(weight.(float64))
+		}
+	}
+
+	return &lambda.AliasRoutingConfiguration{
+		AdditionalVersionWeights: weights,
+	}
+}
+
+func flattenLambdaAliasRoutingConfiguration(c *lambda.AliasRoutingConfiguration) []interface{} {
+	if c == nil || len(c.AdditionalVersionWeights) == 0 {
+		return []interface{}{}
+	}
+
+	weights := make(map[string]interface{}, len(c.AdditionalVersionWeights))
+	for version, weight := range c.AdditionalVersionWeights {
+		weights[version] = aws.Float64Value(weight)
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"additional_version_weights": weights,
+		},
+	}
 }
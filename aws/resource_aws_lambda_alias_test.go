@@ -40,6 +40,55 @@ func TestAccAWSLambdaAlias_basic(t *testing.T) {
 	})
 }
 
+func TestAccAWSLambdaAlias_routingConfig(t *testing.T) {
+	var conf lambda.AliasConfiguration
+
+	rString := acctest.RandString(8)
+	roleName := fmt.Sprintf("tf_acc_role_lambda_alias_routing_%s", rString)
+	policyName := fmt.Sprintf("tf_acc_policy_lambda_alias_routing_%s", rString)
+	attachmentName := fmt.Sprintf("tf_acc_attachment_%s", rString)
+	funcName := fmt.Sprintf("tf_acc_lambda_func_alias_routing_%s", rString)
+	aliasName := fmt.Sprintf("tf_acc_lambda_alias_routing_%s", rString)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsLambdaAliasDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccAwsLambdaAliasConfigRoutingConfig(roleName, policyName, attachmentName, funcName, aliasName, "test-fixtures/lambdatest.zip", ""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsLambdaAliasExists("aws_lambda_alias.lambda_alias_test", &conf),
+					resource.TestCheckResourceAttr("aws_lambda_alias.lambda_alias_test", "routing_config.#", "0"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccAwsLambdaAliasConfigRoutingConfig(roleName, policyName, attachmentName, funcName, aliasName, "test-fixtures/lambda_basic_authorizer.zip", `
+  routing_config {
+    additional_version_weights = {
+      "2" = 0.5
+    }
+  }`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsLambdaAliasExists("aws_lambda_alias.lambda_alias_test", &conf),
+					testAccCheckAwsLambdaAliasRoutingConfig(&conf, map[string]float64{"2": 0.5}),
+					resource.TestCheckResourceAttr("aws_lambda_alias.lambda_alias_test", "routing_config.#", "1"),
+					resource.TestCheckResourceAttr("aws_lambda_alias.lambda_alias_test", "routing_config.0.additional_version_weights.%", "1"),
+					resource.TestCheckResourceAttr("aws_lambda_alias.lambda_alias_test", "routing_config.0.additional_version_weights.2", "0.5"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccAwsLambdaAliasConfigRoutingConfig(roleName, policyName, attachmentName, funcName, aliasName, "test-fixtures/lambda_basic_authorizer.zip", ""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsLambdaAliasExists("aws_lambda_alias.lambda_alias_test", &conf),
+					testAccCheckAwsLambdaAliasRoutingConfig(&conf, map[string]float64{}),
+					resource.TestCheckResourceAttr("aws_lambda_alias.lambda_alias_test", "routing_config.#", "0"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckAwsLambdaAliasDestroy(s *terraform.State) error {
 	conn := testAccProvider.Meta().(*AWSClient).lambdaconn
 
@@ -104,6 +153,25 @@ func testAccCheckAwsLambdaAttributes(mapping *lambda.AliasConfiguration) resourc
 	}
 }
 
+func testAccCheckAwsLambdaAliasRoutingConfig(mapping *lambda.AliasConfiguration, expected map[string]float64) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		var weights map[string]*float64
+		if mapping.RoutingConfig != nil {
+			weights = mapping.RoutingConfig.AdditionalVersionWeights
+		}
+
+		if len(weights) != len(expected) {
+			return fmt.Errorf("Expected %d additional version weights, got %d", len(expected), len(weights))
+		}
+		for version, weight := range expected {
+			if aws.Float64Value(weights[version]) != weight {
+				return fmt.Errorf("Expected weight %v for version %s, got %v", weight, version, aws.Float64Value(weights[version]))
+			}
+		}
+		return nil
+	}
+}
+
 func testAccAwsLambdaAliasConfig(roleName, policyName, attachmentName, funcName, aliasName string) string {
 	return fmt.Sprintf(`
 resource "aws_iam_role" "iam_for_lambda" {
@@ -168,3 +236,71 @@ resource "aws_lambda_alias" "lambda_alias_test" {
   function_version = "$LATEST"
 }`, roleName, policyName, attachmentName, funcName, aliasName)
 }
+
+func testAccAwsLambdaAliasConfigRoutingConfig(roleName, policyName, attachmentName, funcName, aliasName, filename, routingConfig string) string {
+	return fmt.Sprintf(`
+resource "aws_iam_role" "iam_for_lambda" {
+  name = "%s"
+
+  assume_role_policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Action": "sts:AssumeRole",
+      "Principal": {
+        "Service": "lambda.amazonaws.com"
+      },
+      "Effect": "Allow",
+      "Sid": ""
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_iam_policy" "policy_for_role" {
+  name        = "%s"
+  path        = "/"
+  description = "IAM policy for for Lamda alias testing"
+
+  policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+      {
+          "Effect": "Allow",
+          "Action": [
+            "lambda:*"
+          ],
+          "Resource": "*"
+      }
+  ]
+}
+EOF
+}
+
+resource "aws_iam_policy_attachment" "policy_attachment_for_role" {
+  name       = "%s"
+  roles      = ["${aws_iam_role.iam_for_lambda.name}"]
+  policy_arn = "${aws_iam_policy.policy_for_role.arn}"
+}
+
+resource "aws_lambda_function" "lambda_function_test_create" {
+  filename         = "%s"
+  source_code_hash = "${base64sha256(file("%s"))}"
+  function_name    = "%s"
+  role             = "${aws_iam_role.iam_for_lambda.arn}"
+  handler          = "exports.example"
+  runtime          = "nodejs4.3"
+  publish          = true
+}
+
+resource "aws_lambda_alias" "lambda_alias_test" {
+  name             = "%s"
+  description      = "a sample description"
+  function_name    = "${aws_lambda_function.lambda_function_test_create.arn}"
+  function_version = "1"
+%s
+}`, roleName, policyName, attachmentName, filename, filename, funcName, aliasName, routingConfig)
+}
@@ -1,6 +1,7 @@
 package aws
 
 import (
+	"bytes"
 	"fmt"
 	"io/ioutil"
 	"log"
@@ -9,6 +10,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/mitchellh/go-homedir"
 
 	"errors"
@@ -38,7 +40,18 @@ func resourceAwsLambdaFunction() *schema.Resource {
 			"filename": {
 				Type:          schema.TypeString,
 				Optional:      true,
-				ConflictsWith: []string{"s3_bucket", "s3_key", "s3_object_version"},
+				ConflictsWith: []string{"s3_bucket", "s3_key", "s3_object_version", "source_dir"},
+			},
+			"source_dir": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"filename", "s3_object_version", "source_code_hash"},
+			},
+			"source_excludes": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
 			},
 			"s3_bucket": {
 				Type:          schema.TypeString,
@@ -201,8 +214,28 @@ func resourceAwsLambdaFunction() *schema.Resource {
 			"tags": tagsSchema(),
 		},
 
-		CustomizeDiff: updateComputedAttributesOnPublish,
+		CustomizeDiff: resourceAwsLambdaFunctionCustomizeDiff,
+	}
+}
+
+func resourceAwsLambdaFunctionCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	// Package source_dir at plan time so that changes to its contents show up
+	// as a change of source_code_hash.
+	if v, ok := d.GetOk("source_dir"); ok {
+		excludes := aws.StringValueSlice(expandStringSet(d.Get("source_excludes").(*schema.Set)))
+		pkg, err := buildLambdaPackage(v.(string), excludes)
+		if err != nil {
+			return fmt.Errorf("Unable to package %q: %s", v.(string), err)
+		}
+
+		if hash := lambdaPackageHash(pkg); hash != d.Get("source_code_hash").(string) {
+			if err := d.SetNew("source_code_hash", hash); err != nil {
+				return err
+			}
+		}
 	}
+
+	return updateComputedAttributesOnPublish(d, meta)
 }
 
 func updateComputedAttributesOnPublish(d *schema.ResourceDiff, meta interface{}) error {
@@ -229,16 +262,26 @@ func resourceAwsLambdaFunctionCreate(d *schema.ResourceData, meta interface{}) e
 	log.Printf("[DEBUG] Creating Lambda Function %s with role %s", functionName, iamRole)
 
 	filename, hasFilename := d.GetOk("filename")
+	_, hasSourceDir := d.GetOk("source_dir")
 	s3Bucket, bucketOk := d.GetOk("s3_bucket")
 	s3Key, keyOk := d.GetOk("s3_key")
 	s3ObjectVersion, versionOk := d.GetOk("s3_object_version")
 
-	if !hasFilename && !bucketOk && !keyOk && !versionOk {
-		return errors.New("filename or s3_* attributes must be set")
+	if !hasFilename && !hasSourceDir && !bucketOk && !keyOk && !versionOk {
+		return errors.New("filename, source_dir or s3_* attributes must be set")
 	}
 
 	var functionCode *lambda.FunctionCode
-	if hasFilename {
+	if hasSourceDir {
+		// Same as for filename below.
+		awsMutexKV.Lock(awsMutexLambdaKey)
+		defer awsMutexKV.Unlock(awsMutexLambdaKey)
+		var err error
+		functionCode, err = lambdaFunctionCodeFromSourceDir(d, meta)
+		if err != nil {
+			return err
+		}
+	} else if hasFilename {
 		// Grab an exclusive lock so that we're only reading one function into
 		// memory at a time.
 		// See https://github.com/hashicorp/terraform/issues/9364
@@ -544,7 +587,7 @@ type resourceDiffer interface {
 }
 
 func needsFunctionCodeUpdate(d resourceDiffer) bool {
-	return d.HasChange("filename") || d.HasChange("source_code_hash") || d.HasChange("s3_bucket") || d.HasChange("s3_key") || d.HasChange("s3_object_version")
+	return d.HasChange("filename") || d.HasChange("source_dir") || d.HasChange("source_code_hash") || d.HasChange("s3_bucket") || d.HasChange("s3_key") || d.HasChange("s3_object_version")
 }
 
 // resourceAwsLambdaFunctionUpdate maps to:
@@ -700,7 +743,18 @@ func resourceAwsLambdaFunctionUpdate(d *schema.ResourceData, meta interface{}) e
 			Publish:      aws.Bool(d.Get("publish").(bool)),
 		}
 
-		if v, ok := d.GetOk("filename"); ok {
+		if _, ok := d.GetOk("source_dir"); ok {
+			awsMutexKV.Lock(awsMutexLambdaKey)
+			defer awsMutexKV.Unlock(awsMutexLambdaKey)
+			functionCode, err := lambdaFunctionCodeFromSourceDir(d, meta)
+			if err != nil {
+				return err
+			}
+			codeReq.ZipFile = functionCode.ZipFile
+			codeReq.S3Bucket = functionCode.S3Bucket
+			codeReq.S3Key = functionCode.S3Key
+			codeReq.S3ObjectVersion = functionCode.S3ObjectVersion
+		} else if v, ok := d.GetOk("filename"); ok {
 			// Grab an exclusive lock so that we're only reading one function into
 			// memory at a time.
 			// See https://github.com/hashicorp/terraform/issues/9364
@@ -731,6 +785,8 @@ func resourceAwsLambdaFunctionUpdate(d *schema.ResourceData, meta interface{}) e
 		}
 
 		d.SetPartial("filename")
+		d.SetPartial("source_dir")
+		d.SetPartial("source_excludes")
 		d.SetPartial("source_code_hash")
 		d.SetPartial("s3_bucket")
 		d.SetPartial("s3_key")
@@ -770,6 +826,54 @@ func resourceAwsLambdaFunctionUpdate(d *schema.ResourceData, meta interface{}) e
 	return resourceAwsLambdaFunctionRead(d, meta)
 }
 
+// lambdaFunctionCodeFromSourceDir packages source_dir and returns the code to
+// deploy. Packages too large to upload directly are put in s3_bucket first.
+func lambdaFunctionCodeFromSourceDir(d *schema.ResourceData, meta interface{}) (*lambda.FunctionCode, error) {
+	sourceDir := d.Get("source_dir").(string)
+	excludes := aws.StringValueSlice(expandStringSet(d.Get("source_excludes").(*schema.Set)))
+
+	pkg, err := buildLambdaPackage(sourceDir, excludes)
+	if err != nil {
+		return nil, fmt.Errorf("Unable to package %q: %s", sourceDir, err)
+	}
+	log.Printf("[DEBUG] Packaged %q for Lambda Function %s: %d bytes, hash %s",
+		sourceDir, d.Get("function_name").(string), len(pkg), lambdaPackageHash(pkg))
+
+	if len(pkg) <= lambdaDirectUploadLimit {
+		return &lambda.FunctionCode{
+			ZipFile: pkg,
+		}, nil
+	}
+
+	v, ok := d.GetOk("s3_bucket")
+	if !ok {
+		return nil, fmt.Errorf("Package built from %q is %d bytes, which exceeds the %d byte direct upload limit; "+
+			"set s3_bucket to upload it via S3", sourceDir, len(pkg), lambdaDirectUploadLimit)
+	}
+	bucket := v.(string)
+
+	key := lambdaPackageS3Key(d.Get("function_name").(string), pkg)
+	if v, ok := d.GetOk("s3_key"); ok {
+		key = v.(string)
+	}
+
+	log.Printf("[DEBUG] Uploading Lambda Function package to s3://%s/%s", bucket, key)
+	out, err := meta.(*AWSClient).s3conn.PutObject(&s3.PutObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+		Body:   bytes.NewReader(pkg),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Error uploading Lambda Function package to s3://%s/%s: %s", bucket, key, err)
+	}
+
+	return &lambda.FunctionCode{
+		S3Bucket:        aws.String(bucket),
+		S3Key:           aws.String(key),
+		S3ObjectVersion: out.VersionId,
+	}, nil
+}
+
 // loadFileContent returns contents of a file in a given path
 func loadFileContent(v string) ([]byte, error) {
 	filename, err := homedir.Expand(v)
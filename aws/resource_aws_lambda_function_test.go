@@ -172,7 +172,7 @@ func TestAccAWSLambdaFunction_expectFilenameAndS3Attributes(t *testing.T) {
 		Steps: []resource.TestStep{
 			{
 				Config:      testAccAWSLambdaConfigWithoutFilenameAndS3Attributes(funcName, policyName, roleName, sgName),
-				ExpectError: regexp.MustCompile(`filename or s3_\* attributes must be set`),
+				ExpectError: regexp.MustCompile(`filename, source_dir or s3_\* attributes must be set`),
 			},
 		},
 	})
@@ -664,6 +664,82 @@ func TestAccAWSLambdaFunction_localUpdate(t *testing.T) {
 	})
 }
 
+func TestAccAWSLambdaFunction_sourceDir(t *testing.T) {
+	var conf lambda.GetFunctionOutput
+
+	dir, err := ioutil.TempDir("", "lambda_sourceDir")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	rString := acctest.RandString(8)
+	funcName := fmt.Sprintf("tf_acc_lambda_func_source_dir_%s", rString)
+	roleName := fmt.Sprintf("tf_acc_role_lambda_func_source_dir_%s", rString)
+
+	copyFixture := func(source, destination string) {
+		content, err := ioutil.ReadFile(source)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(filepath.Join(dir, destination), content, 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	var hash string
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckLambdaFunctionDestroy,
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					copyFixture("test-fixtures/lambda_func.js", "lambda.js")
+					copyFixture("test-fixtures/lambda_func_modified.js", "lambda_test.js")
+					pkg, err := buildLambdaPackage(dir, []string{"*_test.js"})
+					if err != nil {
+						t.Fatal(err)
+					}
+					hash = lambdaPackageHash(pkg)
+				},
+				Config: genAWSLambdaFunctionConfig_sourceDir(dir, roleName, funcName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsLambdaFunctionExists("aws_lambda_function.lambda_function_source_dir", funcName, &conf),
+					func(s *terraform.State) error {
+						return testAccCheckAwsLambdaSourceCodeHash(&conf, hash)(s)
+					},
+				),
+			},
+			{
+				// Excluded files don't affect the package.
+				PreConfig: func() {
+					copyFixture("test-fixtures/lambda_func.js", "lambda_test.js")
+				},
+				Config:   genAWSLambdaFunctionConfig_sourceDir(dir, roleName, funcName),
+				PlanOnly: true,
+			},
+			{
+				PreConfig: func() {
+					copyFixture("test-fixtures/lambda_func_modified.js", "lambda.js")
+					pkg, err := buildLambdaPackage(dir, []string{"*_test.js"})
+					if err != nil {
+						t.Fatal(err)
+					}
+					hash = lambdaPackageHash(pkg)
+				},
+				Config: genAWSLambdaFunctionConfig_sourceDir(dir, roleName, funcName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsLambdaFunctionExists("aws_lambda_function.lambda_function_source_dir", funcName, &conf),
+					func(s *terraform.State) error {
+						return testAccCheckAwsLambdaSourceCodeHash(&conf, hash)(s)
+					},
+				),
+			},
+		},
+	})
+}
+
 func TestAccAWSLambdaFunction_localUpdate_nameOnly(t *testing.T) {
 	var conf lambda.GetFunctionOutput
 
@@ -1842,6 +1918,37 @@ data "template_file" "last_modified" {
 `, roleName, filePath, filePath, funcName)
 }
 
+func genAWSLambdaFunctionConfig_sourceDir(sourceDir, roleName, funcName string) string {
+	return fmt.Sprintf(`
+resource "aws_iam_role" "iam_for_lambda" {
+    name = "%s"
+    assume_role_policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Action": "sts:AssumeRole",
+      "Principal": {
+        "Service": "lambda.amazonaws.com"
+      },
+      "Effect": "Allow",
+      "Sid": ""
+    }
+  ]
+}
+EOF
+}
+resource "aws_lambda_function" "lambda_function_source_dir" {
+    source_dir = "%s"
+    source_excludes = ["*_test.js"]
+    function_name = "%s"
+    role = "${aws_iam_role.iam_for_lambda.arn}"
+    handler = "lambda.handler"
+    runtime = "nodejs4.3"
+}
+`, roleName, sourceDir, funcName)
+}
+
 func genAWSLambdaFunctionConfig_local_name_only(filePath, roleName, funcName string) string {
 	return testAccAWSLambdaFunctionConfig_local_name_only_tpl(filePath, roleName, funcName)
 }
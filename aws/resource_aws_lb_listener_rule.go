@@ -125,13 +125,23 @@ func resourceAwsLbListenerRuleCreate(d *schema.ResourceData, meta interface{}) e
 			return fmt.Errorf("Error creating LB Listener Rule: %v", err)
 		}
 	} else {
+		// Serialise allocation per listener so that rules created in parallel
+		// by this run don't all pick the same priority. Other writers can
+		// still race us, which is why PriorityInUse is retried.
+		awsMutexKV.Lock(listenerArn)
+		defer awsMutexKV.Unlock(listenerArn)
+
 		err := resource.Retry(5*time.Minute, func() *resource.RetryError {
 			var err error
-			priority, err := highestListenerRulePriority(elbconn, listenerArn)
+			priorities, err := listenerRulePriorities(elbconn, listenerArn)
+			if err != nil {
+				return resource.NonRetryableError(err)
+			}
+			priority, err := nextFreeListenerRulePriority(priorities)
 			if err != nil {
 				return resource.NonRetryableError(err)
 			}
-			params.Priority = aws.Int64(priority + 1)
+			params.Priority = aws.Int64(priority)
 			resp, err = elbconn.CreateRule(params)
 			if err != nil {
 				if isAWSErr(err, elbv2.ErrCodePriorityInUseException, "") {
@@ -342,18 +352,19 @@ func isRuleNotFound(err error) bool {
 	return ok && elberr.Code() == "RuleNotFound"
 }
 
-func highestListenerRulePriority(conn *elbv2.ELBV2, arn string) (priority int64, err error) {
+// listenerRulePriorities returns the sorted priorities of all non-default
+// rules on the given listener.
+func listenerRulePriorities(conn *elbv2.ELBV2, arn string) ([]int, error) {
 	var priorities []int
 	var nextMarker *string
 
 	for {
-		out, aerr := conn.DescribeRules(&elbv2.DescribeRulesInput{
+		out, err := conn.DescribeRules(&elbv2.DescribeRulesInput{
 			ListenerArn: aws.String(arn),
 			Marker:      nextMarker,
 		})
-		if aerr != nil {
-			err = aerr
-			return
+		if err != nil {
+			return nil, err
 		}
 		for _, rule := range out.Rules {
 			if *rule.Priority != "default" {
@@ -367,13 +378,35 @@ func highestListenerRulePriority(conn *elbv2.ELBV2, arn string) (priority int64,
 		nextMarker = out.NextMarker
 	}
 
+	sort.Ints(priorities)
+	return priorities, nil
+}
+
+// nextFreeListenerRulePriority returns the priority after the highest of the
+// given sorted rule priorities, so that new rules are evaluated after existing
+// ones. Only once the highest priority is taken does it fall back to the
+// lowest unused one.
+func nextFreeListenerRulePriority(priorities []int) (int64, error) {
 	if len(priorities) == 0 {
-		priority = 0
-		return
+		return 1, nil
+	}
+	if highest := priorities[len(priorities)-1]; highest < 50000 {
+		return int64(highest + 1), nil
 	}
 
-	sort.IntSlice(priorities).Sort()
-	priority = int64(priorities[len(priorities)-1])
+	next := 1
+	for _, p := range priorities {
+		if p > next {
+			break
+		}
+		if p == next {
+			next++
+		}
+	}
 
-	return
+	if next > 50000 {
+		return 0, errors.New("all LB Listener Rule priorities between 1 and 50000 are in use")
+	}
+
+	return int64(next), nil
 }
@@ -14,6 +14,66 @@ import (
 	"github.com/hashicorp/terraform/terraform"
 )
 
+func TestNextFreeListenerRulePriority(t *testing.T) {
+	all := make([]int, 50000)
+	for i := range all {
+		all[i] = i + 1
+	}
+
+	cases := []struct {
+		name       string
+		priorities []int
+		expected   int64
+		expectErr  bool
+	}{
+		{
+			name:     "no rules",
+			expected: 1,
+		},
+		{
+			name:       "contiguous",
+			priorities: []int{1, 2, 3},
+			expected:   4,
+		},
+		{
+			name:       "gap below highest",
+			priorities: []int{1, 2, 4, 10},
+			expected:   11,
+		},
+		{
+			name:       "highest in use, gap below",
+			priorities: []int{1, 2, 4, 50000},
+			expected:   3,
+		},
+		{
+			name:       "highest in use, first priority free",
+			priorities: []int{10, 50000},
+			expected:   1,
+		},
+		{
+			name:       "all in use",
+			priorities: all,
+			expectErr:  true,
+		},
+	}
+
+	for _, tc := range cases {
+		actual, err := nextFreeListenerRulePriority(tc.priorities)
+		if tc.expectErr {
+			if err == nil {
+				t.Fatalf("%s: expected an error", tc.name)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %s", tc.name, err)
+		}
+		if actual != tc.expected {
+			t.Fatalf("%s: expected %d, got %d", tc.name, tc.expected, actual)
+		}
+	}
+}
+
 func TestLBListenerARNFromRuleARN(t *testing.T) {
 	cases := []struct {
 		name     string
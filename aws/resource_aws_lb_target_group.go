@@ -82,6 +82,28 @@ func resourceAwsLbTargetGroup() *schema.Resource {
 				ValidateFunc: validateAwsLbTargetGroupDeregistrationDelay,
 			},
 
+			"slow_start": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validateAwsLbTargetGroupSlowStart,
+			},
+
+			"proxy_protocol_v2": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			// attributes passes through target group attributes which have no
+			// dedicated argument, keyed as in ModifyTargetGroupAttributes.
+			"attributes": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
 			"target_type": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -330,6 +352,46 @@ func resourceAwsLbTargetGroupUpdate(d *schema.ResourceData, meta interface{}) er
 		})
 	}
 
+	// Slow start is only supported by HTTP(S) target groups and proxy protocol
+	// only by TCP ones; CustomizeDiff rejects enabling either on the other.
+	if d.HasChange("slow_start") && d.Get("protocol") != "TCP" {
+		attrs = append(attrs, &elbv2.TargetGroupAttribute{
+			Key:   aws.String("slow_start.duration_seconds"),
+			Value: aws.String(fmt.Sprintf("%d", d.Get("slow_start").(int))),
+		})
+	}
+
+	if d.HasChange("proxy_protocol_v2") && d.Get("protocol") == "TCP" {
+		attrs = append(attrs, &elbv2.TargetGroupAttribute{
+			Key:   aws.String("proxy_protocol_v2.enabled"),
+			Value: aws.String(strconv.FormatBool(d.Get("proxy_protocol_v2").(bool))),
+		})
+	}
+
+	if d.HasChange("attributes") {
+		o, n := d.GetChange("attributes")
+		oldAttrs := o.(map[string]interface{})
+		newAttrs := n.(map[string]interface{})
+
+		for k, v := range newAttrs {
+			if ov, ok := oldAttrs[k]; ok && ov == v {
+				continue
+			}
+			attrs = append(attrs, &elbv2.TargetGroupAttribute{
+				Key:   aws.String(k),
+				Value: aws.String(v.(string)),
+			})
+		}
+
+		// There's no way to reset an attribute to its default, so removing
+		// it from the map only stops Terraform from managing it.
+		for k := range oldAttrs {
+			if _, ok := newAttrs[k]; !ok {
+				log.Printf("[DEBUG] Target Group attribute %q removed from configuration, leaving it unchanged", k)
+			}
+		}
+	}
+
 	// In CustomizeDiff we allow LB stickiness to be declared for TCP target
 	// groups, so long as it's not enabled. This allows for better support for
 	// modules, but also means we need to completely skip sending the data to the
@@ -477,6 +539,14 @@ func validateAwsLbTargetGroupDeregistrationDelay(v interface{}, k string) (ws []
 	return
 }
 
+func validateAwsLbTargetGroupSlowStart(v interface{}, k string) (ws []string, errors []error) {
+	duration := v.(int)
+	if duration != 0 && (duration < 30 || duration > 900) {
+		errors = append(errors, fmt.Errorf("%q must be 0 to disable, or in the range 30-900 seconds", k))
+	}
+	return
+}
+
 func validateAwsLbTargetGroupStickinessType(v interface{}, k string) (ws []string, errors []error) {
 	stickinessType := v.(string)
 	if stickinessType != "lb_cookie" {
@@ -564,6 +634,10 @@ func flattenAwsLbTargetGroupResource(d *schema.ResourceData, meta interface{}, t
 		}
 	}
 
+	if err = flattenAwsLbTargetGroupAttributes(d, attrResp.Attributes); err != nil {
+		return err
+	}
+
 	tagsResp, err := elbconn.DescribeTags(&elbv2.DescribeTagsInput{
 		ResourceArns: []*string{aws.String(d.Id())},
 	})
@@ -618,7 +692,72 @@ func flattenAwsLbTargetGroupStickiness(d *schema.ResourceData, attributes []*elb
 	return nil
 }
 
+// flattenAwsLbTargetGroupAttributes sets slow_start and proxy_protocol_v2,
+// and reads every attribute without a dedicated argument into attributes.
+// Once attributes is configured only the configured keys are refreshed, so
+// that attributes added by AWS later don't show up as a diff.
+func flattenAwsLbTargetGroupAttributes(d *schema.ResourceData, attributes []*elbv2.TargetGroupAttribute) error {
+	configured := d.Get("attributes").(map[string]interface{})
+	passthrough := make(map[string]interface{})
+
+	for _, attr := range attributes {
+		key := aws.StringValue(attr.Key)
+		switch key {
+		case "slow_start.duration_seconds":
+			duration, err := strconv.Atoi(aws.StringValue(attr.Value))
+			if err != nil {
+				return fmt.Errorf("Error converting slow_start.duration_seconds to int: %s", aws.StringValue(attr.Value))
+			}
+			d.Set("slow_start", duration)
+		case "proxy_protocol_v2.enabled":
+			enabled, err := strconv.ParseBool(aws.StringValue(attr.Value))
+			if err != nil {
+				return fmt.Errorf("Error converting proxy_protocol_v2.enabled to bool: %s", aws.StringValue(attr.Value))
+			}
+			d.Set("proxy_protocol_v2", enabled)
+		}
+
+		if isLbTargetGroupManagedAttribute(key) {
+			continue
+		}
+		if _, ok := configured[key]; ok || len(configured) == 0 {
+			passthrough[key] = aws.StringValue(attr.Value)
+		}
+	}
+
+	if err := d.Set("attributes", passthrough); err != nil {
+		return fmt.Errorf("Error setting attributes: %s", err)
+	}
+	return nil
+}
+
+// lbTargetGroupManagedAttributes are the target group attributes which have
+// a dedicated argument and so can't be set through attributes.
+var lbTargetGroupManagedAttributes = []string{
+	"deregistration_delay.timeout_seconds",
+	"proxy_protocol_v2.enabled",
+	"slow_start.duration_seconds",
+	"stickiness.enabled",
+	"stickiness.lb_cookie.duration_seconds",
+	"stickiness.type",
+}
+
+func isLbTargetGroupManagedAttribute(key string) bool {
+	for _, k := range lbTargetGroupManagedAttributes {
+		if k == key {
+			return true
+		}
+	}
+	return false
+}
+
 func resourceAwsLbTargetGroupCustomizeDiff(diff *schema.ResourceDiff, v interface{}) error {
+	for k := range diff.Get("attributes").(map[string]interface{}) {
+		if isLbTargetGroupManagedAttribute(k) {
+			return fmt.Errorf("Target Group attribute %q has a dedicated argument and cannot be set in attributes", k)
+		}
+	}
+
 	protocol := diff.Get("protocol").(string)
 	if protocol == "TCP" {
 		// TCP load balancers do not support stickiness
@@ -628,6 +767,11 @@ func resourceAwsLbTargetGroupCustomizeDiff(diff *schema.ResourceDiff, v interfac
 				return fmt.Errorf("Network Load Balancers do not support Stickiness")
 			}
 		}
+		if diff.Get("slow_start").(int) != 0 {
+			return fmt.Errorf("Network Load Balancers do not support Slow Start")
+		}
+	} else if diff.Get("proxy_protocol_v2").(bool) {
+		return fmt.Errorf("Proxy Protocol v2 is only supported by TCP Target Groups")
 	}
 
 	// Network Load Balancers have many special qwirks to them.
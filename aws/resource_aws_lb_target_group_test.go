@@ -740,6 +740,92 @@ func TestAccAWSLBTargetGroup_stickinessWithTCPEnabledShouldError(t *testing.T) {
 	})
 }
 
+func TestAccAWSLBTargetGroup_slowStart(t *testing.T) {
+	var conf elbv2.TargetGroup
+	targetGroupName := fmt.Sprintf("test-target-group-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:      func() { testAccPreCheck(t) },
+		IDRefreshName: "aws_lb_target_group.test",
+		Providers:     testAccProviders,
+		CheckDestroy:  testAccCheckAWSLBTargetGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSLBTargetGroupConfig_slowStart(targetGroupName, 0),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSLBTargetGroupExists("aws_lb_target_group.test", &conf),
+					resource.TestCheckResourceAttr("aws_lb_target_group.test", "slow_start", "0"),
+					resource.TestCheckResourceAttr("aws_lb_target_group.test", "proxy_protocol_v2", "false"),
+					resource.TestCheckResourceAttr("aws_lb_target_group.test", "attributes.%", "0"),
+				),
+			},
+			{
+				Config: testAccAWSLBTargetGroupConfig_slowStart(targetGroupName, 30),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSLBTargetGroupExists("aws_lb_target_group.test", &conf),
+					resource.TestCheckResourceAttr("aws_lb_target_group.test", "slow_start", "30"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSLBTargetGroup_proxyProtocolV2(t *testing.T) {
+	var conf elbv2.TargetGroup
+	targetGroupName := fmt.Sprintf("test-target-group-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:      func() { testAccPreCheck(t) },
+		IDRefreshName: "aws_lb_target_group.test",
+		Providers:     testAccProviders,
+		CheckDestroy:  testAccCheckAWSLBTargetGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSLBTargetGroupConfig_proxyProtocolV2(targetGroupName, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSLBTargetGroupExists("aws_lb_target_group.test", &conf),
+					resource.TestCheckResourceAttr("aws_lb_target_group.test", "proxy_protocol_v2", "true"),
+				),
+			},
+			{
+				Config: testAccAWSLBTargetGroupConfig_proxyProtocolV2(targetGroupName, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSLBTargetGroupExists("aws_lb_target_group.test", &conf),
+					resource.TestCheckResourceAttr("aws_lb_target_group.test", "proxy_protocol_v2", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSLBTargetGroup_slowStartWithTCPShouldError(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAWSLBTargetGroupConfig_slowStartWithTCP,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Network Load Balancers do not support Slow Start"),
+			},
+		},
+	})
+}
+
+func TestAccAWSLBTargetGroup_attributesManagedKeyShouldError(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAWSLBTargetGroupConfig_attributesManagedKey,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("has a dedicated argument"),
+			},
+		},
+	})
+}
+
 func testAccCheckAWSLBTargetGroupExists(n string, res *elbv2.TargetGroup) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -1420,3 +1506,78 @@ resource "aws_vpc" "test" {
 }
 `, enabled)
 }
+
+func testAccAWSLBTargetGroupConfig_slowStart(targetGroupName string, slowStart int) string {
+	return fmt.Sprintf(`resource "aws_lb_target_group" "test" {
+  name       = "%s"
+  port       = 443
+  protocol   = "HTTPS"
+  vpc_id     = "${aws_vpc.test.id}"
+  slow_start = %d
+}
+
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+
+  tags {
+    Name = "terraform-testacc-lb-target-group-slow-start"
+  }
+}`, targetGroupName, slowStart)
+}
+
+func testAccAWSLBTargetGroupConfig_proxyProtocolV2(targetGroupName string, enabled bool) string {
+	return fmt.Sprintf(`resource "aws_lb_target_group" "test" {
+  name              = "%s"
+  port              = 8082
+  protocol          = "TCP"
+  vpc_id            = "${aws_vpc.test.id}"
+  proxy_protocol_v2 = %t
+}
+
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+
+  tags {
+    Name = "terraform-testacc-lb-target-group-proxy-protocol-v2"
+  }
+}`, targetGroupName, enabled)
+}
+
+const testAccAWSLBTargetGroupConfig_slowStartWithTCP = `
+resource "aws_lb_target_group" "test" {
+  name_prefix = "tf-"
+  port        = 25
+  protocol    = "TCP"
+  vpc_id      = "${aws_vpc.test.id}"
+  slow_start  = 30
+}
+
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+
+  tags {
+    Name = "terraform-testacc-lb-target-group-slow-start-tcp"
+  }
+}
+`
+
+const testAccAWSLBTargetGroupConfig_attributesManagedKey = `
+resource "aws_lb_target_group" "test" {
+  name_prefix = "tf-"
+  port        = 443
+  protocol    = "HTTPS"
+  vpc_id      = "${aws_vpc.test.id}"
+
+  attributes {
+    "slow_start.duration_seconds" = "30"
+  }
+}
+
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+
+  tags {
+    Name = "terraform-testacc-lb-target-group-attributes-managed-key"
+  }
+}
+`
@@ -0,0 +1,383 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsS3BucketAnalyticsConfiguration() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsS3BucketAnalyticsConfigurationPut,
+		Read:   resourceAwsS3BucketAnalyticsConfigurationRead,
+		Update: resourceAwsS3BucketAnalyticsConfigurationPut,
+		Delete: resourceAwsS3BucketAnalyticsConfigurationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"filter": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"prefix": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"tags": tagsSchema(),
+					},
+				},
+			},
+			"storage_class_analysis": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"data_export": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"output_schema_version": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Default:  s3.StorageClassAnalysisSchemaVersionV1,
+										ValidateFunc: validation.StringInSlice([]string{
+											s3.StorageClassAnalysisSchemaVersionV1,
+										}, false),
+									},
+									"destination": {
+										Type:     schema.TypeList,
+										Required: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"s3_bucket_destination": {
+													Type:     schema.TypeList,
+													Required: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"bucket_arn": {
+																Type:         schema.TypeString,
+																Required:     true,
+																ValidateFunc: validateArn,
+															},
+															"bucket_account_id": {
+																Type:         schema.TypeString,
+																Optional:     true,
+																ValidateFunc: validateAwsAccountId,
+															},
+															"format": {
+																Type:     schema.TypeString,
+																Optional: true,
+																Default:  s3.AnalyticsS3ExportFileFormatCsv,
+																ValidateFunc: validation.StringInSlice([]string{
+																	s3.AnalyticsS3ExportFileFormatCsv,
+																}, false),
+															},
+															"prefix": {
+																Type:     schema.TypeString,
+																Optional: true,
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceAwsS3BucketAnalyticsConfigurationPut(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).s3conn
+	bucket := d.Get("bucket").(string)
+	name := d.Get("name").(string)
+
+	analyticsConfiguration := &s3.AnalyticsConfiguration{
+		Id:                   aws.String(name),
+		StorageClassAnalysis: expandS3StorageClassAnalysis(d.Get("storage_class_analysis").([]interface{})),
+	}
+
+	if v, ok := d.GetOk("filter"); ok {
+		filterList := v.([]interface{})
+		if filterMap, ok := filterList[0].(map[string]interface{}); ok {
+			analyticsConfiguration.Filter = expandS3AnalyticsFilter(filterMap)
+		}
+	}
+
+	input := &s3.PutBucketAnalyticsConfigurationInput{
+		Bucket:                 aws.String(bucket),
+		Id:                     aws.String(name),
+		AnalyticsConfiguration: analyticsConfiguration,
+	}
+
+	log.Printf("[DEBUG] Putting S3 bucket analytics configuration: %s", input)
+	err := resource.Retry(1*time.Minute, func() *resource.RetryError {
+		_, err := conn.PutBucketAnalyticsConfiguration(input)
+		if err != nil {
+			if isAWSErr(err, s3.ErrCodeNoSuchBucket, "") {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("Error putting S3 bucket analytics configuration: %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", bucket, name))
+
+	return resourceAwsS3BucketAnalyticsConfigurationRead(d, meta)
+}
+
+func resourceAwsS3BucketAnalyticsConfigurationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).s3conn
+
+	bucket, name, err := resourceAwsS3BucketAnalyticsConfigurationParseID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	d.Set("bucket", bucket)
+	d.Set("name", name)
+
+	input := &s3.GetBucketAnalyticsConfigurationInput{
+		Bucket: aws.String(bucket),
+		Id:     aws.String(name),
+	}
+
+	log.Printf("[DEBUG] Reading S3 bucket analytics configuration: %s", input)
+	output, err := conn.GetBucketAnalyticsConfiguration(input)
+	if err != nil {
+		if isAWSErr(err, s3.ErrCodeNoSuchBucket, "") || isAWSErr(err, "NoSuchConfiguration", "") {
+			log.Printf("[WARN] %s S3 bucket analytics configuration not found, removing from state.", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading S3 bucket analytics configuration: %s", err)
+	}
+
+	if output.AnalyticsConfiguration == nil {
+		log.Printf("[WARN] %s S3 bucket analytics configuration not found, removing from state.", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	filter := []interface{}{}
+	if output.AnalyticsConfiguration.Filter != nil {
+		filter = append(filter, flattenS3AnalyticsFilter(output.AnalyticsConfiguration.Filter))
+	}
+	if err := d.Set("filter", filter); err != nil {
+		return fmt.Errorf("Error setting filter: %s", err)
+	}
+
+	if err := d.Set("storage_class_analysis", flattenS3StorageClassAnalysis(output.AnalyticsConfiguration.StorageClassAnalysis)); err != nil {
+		return fmt.Errorf("Error setting storage_class_analysis: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsS3BucketAnalyticsConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).s3conn
+
+	bucket, name, err := resourceAwsS3BucketAnalyticsConfigurationParseID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	input := &s3.DeleteBucketAnalyticsConfigurationInput{
+		Bucket: aws.String(bucket),
+		Id:     aws.String(name),
+	}
+
+	log.Printf("[DEBUG] Deleting S3 bucket analytics configuration: %s", input)
+	_, err = conn.DeleteBucketAnalyticsConfiguration(input)
+	if err != nil {
+		if isAWSErr(err, s3.ErrCodeNoSuchBucket, "") || isAWSErr(err, "NoSuchConfiguration", "") {
+			return nil
+		}
+		return fmt.Errorf("Error deleting S3 bucket analytics configuration: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsS3BucketAnalyticsConfigurationParseID(id string) (string, string, error) {
+	idParts := strings.Split(id, ":")
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		return "", "", fmt.Errorf("please make sure the ID is in the form BUCKET:NAME (i.e. my-bucket:EntireBucket")
+	}
+	bucket := idParts[0]
+	name := idParts[1]
+	return bucket, name, nil
+}
+
+func expandS3AnalyticsFilter(m map[string]interface{}) *s3.AnalyticsFilter {
+	var prefix string
+	if v, ok := m["prefix"]; ok {
+		prefix = v.(string)
+	}
+
+	var tags []*s3.Tag
+	if v, ok := m["tags"]; ok {
+		tags = tagsFromMapS3(v.(map[string]interface{}))
+	}
+
+	if prefix == "" && len(tags) == 0 {
+		return nil
+	}
+
+	analyticsFilter := &s3.AnalyticsFilter{}
+	if prefix != "" && len(tags) > 0 {
+		analyticsFilter.And = &s3.AnalyticsAndOperator{
+			Prefix: aws.String(prefix),
+			Tags:   tags,
+		}
+	} else if len(tags) > 1 {
+		analyticsFilter.And = &s3.AnalyticsAndOperator{
+			Tags: tags,
+		}
+	} else if len(tags) == 1 {
+		analyticsFilter.Tag = tags[0]
+	} else {
+		analyticsFilter.Prefix = aws.String(prefix)
+	}
+	return analyticsFilter
+}
+
+func flattenS3AnalyticsFilter(analyticsFilter *s3.AnalyticsFilter) map[string]interface{} {
+	m := make(map[string]interface{})
+
+	if analyticsFilter.And != nil {
+		and := *analyticsFilter.And
+		if and.Prefix != nil {
+			m["prefix"] = *and.Prefix
+		}
+		if and.Tags != nil {
+			m["tags"] = tagsToMapS3(and.Tags)
+		}
+	} else if analyticsFilter.Prefix != nil {
+		m["prefix"] = *analyticsFilter.Prefix
+	} else if analyticsFilter.Tag != nil {
+		tags := []*s3.Tag{
+			analyticsFilter.Tag,
+		}
+		m["tags"] = tagsToMapS3(tags)
+	}
+	return m
+}
+
+func expandS3StorageClassAnalysis(l []interface{}) *s3.StorageClassAnalysis {
+	result := &s3.StorageClassAnalysis{}
+
+	if len(l) == 0 || l[0] == nil {
+		return result
+	}
+
+	m := l[0].(map[string]interface{})
+	dataExports := m["data_export"].([]interface{})
+	if len(dataExports) == 0 || dataExports[0] == nil {
+		return result
+	}
+
+	dataExport := dataExports[0].(map[string]interface{})
+	result.DataExport = &s3.StorageClassAnalysisDataExport{
+		OutputSchemaVersion: aws.String(dataExport["output_schema_version"].(string)),
+		Destination:         &s3.AnalyticsExportDestination{},
+	}
+
+	destinations := dataExport["destination"].([]interface{})
+	if len(destinations) == 0 || destinations[0] == nil {
+		return result
+	}
+
+	destination := destinations[0].(map[string]interface{})
+	bucketDestinations := destination["s3_bucket_destination"].([]interface{})
+	if len(bucketDestinations) == 0 || bucketDestinations[0] == nil {
+		return result
+	}
+
+	bucketDestination := bucketDestinations[0].(map[string]interface{})
+	s3BucketDestination := &s3.AnalyticsS3BucketDestination{
+		Bucket: aws.String(bucketDestination["bucket_arn"].(string)),
+		Format: aws.String(bucketDestination["format"].(string)),
+	}
+	if v, ok := bucketDestination["bucket_account_id"]; ok && v.(string) != "" {
+		s3BucketDestination.BucketAccountId = aws.String(v.(string))
+	}
+	if v, ok := bucketDestination["prefix"]; ok && v.(string) != "" {
+		s3BucketDestination.Prefix = aws.String(v.(string))
+	}
+	result.DataExport.Destination.S3BucketDestination = s3BucketDestination
+
+	return result
+}
+
+func flattenS3StorageClassAnalysis(storageClassAnalysis *s3.StorageClassAnalysis) []interface{} {
+	if storageClassAnalysis == nil || storageClassAnalysis.DataExport == nil {
+		return []interface{}{}
+	}
+
+	dataExport := storageClassAnalysis.DataExport
+	de := map[string]interface{}{
+		"output_schema_version": aws.StringValue(dataExport.OutputSchemaVersion),
+	}
+
+	if dataExport.Destination != nil && dataExport.Destination.S3BucketDestination != nil {
+		bucketDestination := dataExport.Destination.S3BucketDestination
+		bd := map[string]interface{}{
+			"bucket_arn": aws.StringValue(bucketDestination.Bucket),
+			"format":     aws.StringValue(bucketDestination.Format),
+		}
+		if bucketDestination.BucketAccountId != nil {
+			bd["bucket_account_id"] = aws.StringValue(bucketDestination.BucketAccountId)
+		}
+		if bucketDestination.Prefix != nil {
+			bd["prefix"] = aws.StringValue(bucketDestination.Prefix)
+		}
+
+		de["destination"] = []interface{}{
+			map[string]interface{}{
+				"s3_bucket_destination": []interface{}{bd},
+			},
+		}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"data_export": []interface{}{de},
+		},
+	}
+}
@@ -0,0 +1,428 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+)
+
+func TestExpandS3AnalyticsFilter(t *testing.T) {
+	testCases := []struct {
+		Config   map[string]interface{}
+		Expected *s3.AnalyticsFilter
+	}{
+		{
+			Config:   map[string]interface{}{},
+			Expected: nil,
+		},
+		{
+			Config: map[string]interface{}{
+				"prefix": "prefix/",
+			},
+			Expected: &s3.AnalyticsFilter{
+				Prefix: aws.String("prefix/"),
+			},
+		},
+		{
+			Config: map[string]interface{}{
+				"tags": map[string]interface{}{
+					"tag1key": "tag1value",
+				},
+			},
+			Expected: &s3.AnalyticsFilter{
+				Tag: &s3.Tag{
+					Key:   aws.String("tag1key"),
+					Value: aws.String("tag1value"),
+				},
+			},
+		},
+		{
+			Config: map[string]interface{}{
+				"prefix": "prefix/",
+				"tags": map[string]interface{}{
+					"tag1key": "tag1value",
+				},
+			},
+			Expected: &s3.AnalyticsFilter{
+				And: &s3.AnalyticsAndOperator{
+					Prefix: aws.String("prefix/"),
+					Tags: []*s3.Tag{
+						{
+							Key:   aws.String("tag1key"),
+							Value: aws.String("tag1value"),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for i, tc := range testCases {
+		value := expandS3AnalyticsFilter(tc.Config)
+		if !reflect.DeepEqual(value, tc.Expected) {
+			t.Errorf("Case #%d: Given:\n%s\n\nExpected:\n%s", i, value, tc.Expected)
+		}
+	}
+}
+
+func TestExpandS3StorageClassAnalysis(t *testing.T) {
+	testCases := []struct {
+		Config   []interface{}
+		Expected *s3.StorageClassAnalysis
+	}{
+		{
+			Config:   []interface{}{},
+			Expected: &s3.StorageClassAnalysis{},
+		},
+		{
+			Config: []interface{}{
+				map[string]interface{}{
+					"data_export": []interface{}{
+						map[string]interface{}{
+							"output_schema_version": s3.StorageClassAnalysisSchemaVersionV1,
+							"destination": []interface{}{
+								map[string]interface{}{
+									"s3_bucket_destination": []interface{}{
+										map[string]interface{}{
+											"bucket_arn":        "arn:aws:s3:::destination",
+											"bucket_account_id": "123456789012",
+											"format":            s3.AnalyticsS3ExportFileFormatCsv,
+											"prefix":            "analytics/",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			Expected: &s3.StorageClassAnalysis{
+				DataExport: &s3.StorageClassAnalysisDataExport{
+					OutputSchemaVersion: aws.String(s3.StorageClassAnalysisSchemaVersionV1),
+					Destination: &s3.AnalyticsExportDestination{
+						S3BucketDestination: &s3.AnalyticsS3BucketDestination{
+							Bucket:          aws.String("arn:aws:s3:::destination"),
+							BucketAccountId: aws.String("123456789012"),
+							Format:          aws.String(s3.AnalyticsS3ExportFileFormatCsv),
+							Prefix:          aws.String("analytics/"),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for i, tc := range testCases {
+		value := expandS3StorageClassAnalysis(tc.Config)
+		if !reflect.DeepEqual(value, tc.Expected) {
+			t.Errorf("Case #%d: Given:\n%s\n\nExpected:\n%s", i, value, tc.Expected)
+		}
+
+		// Flattening the expanded value should give back what was configured.
+		if flattened := flattenS3StorageClassAnalysis(value); !reflect.DeepEqual(flattened, tc.Config) {
+			t.Errorf("Case #%d: flattened:\n%#v\n\nExpected:\n%#v", i, flattened, tc.Config)
+		}
+	}
+}
+
+func TestResourceAwsS3BucketAnalyticsConfigurationParseID(t *testing.T) {
+	validIds := []string{
+		"foo:bar",
+		"my-bucket:entire-bucket",
+	}
+
+	for _, s := range validIds {
+		_, _, err := resourceAwsS3BucketAnalyticsConfigurationParseID(s)
+		if err != nil {
+			t.Fatalf("%s should be a valid S3 bucket analytics configuration id: %s", s, err)
+		}
+	}
+
+	invalidIds := []string{
+		"",
+		"foo",
+		"foo:",
+		"foo:bar:baz",
+		"foo::bar",
+		"foo.bar",
+	}
+
+	for _, s := range invalidIds {
+		_, _, err := resourceAwsS3BucketAnalyticsConfigurationParseID(s)
+		if err == nil {
+			t.Fatalf("%s should not be a valid S3 bucket analytics configuration id", s)
+		}
+	}
+}
+
+func TestAccAWSS3BucketAnalyticsConfiguration_basic(t *testing.T) {
+	var ac s3.AnalyticsConfiguration
+	rInt := acctest.RandInt()
+	resourceName := "aws_s3_bucket_analytics_configuration.test"
+	bucketName := fmt.Sprintf("tf-acc-%d", rInt)
+	name := t.Name()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSS3BucketAnalyticsConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSS3BucketAnalyticsConfigurationConfig(bucketName, name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketAnalyticsConfigurationExists(resourceName, &ac),
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "bucket", bucketName),
+					resource.TestCheckResourceAttr(resourceName, "filter.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "storage_class_analysis.#", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSS3BucketAnalyticsConfiguration_WithFilter(t *testing.T) {
+	var ac s3.AnalyticsConfiguration
+	rInt := acctest.RandInt()
+	resourceName := "aws_s3_bucket_analytics_configuration.test"
+	bucketName := fmt.Sprintf("tf-acc-%d", rInt)
+	name := t.Name()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSS3BucketAnalyticsConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSS3BucketAnalyticsConfigurationWithFilterPrefix(bucketName, name, "prefix/"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketAnalyticsConfigurationExists(resourceName, &ac),
+					resource.TestCheckResourceAttr(resourceName, "filter.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "filter.0.prefix", "prefix/"),
+					resource.TestCheckResourceAttr(resourceName, "filter.0.tags.%", "0"),
+				),
+			},
+			{
+				Config: testAccAWSS3BucketAnalyticsConfigurationWithFilterPrefixAndTags(bucketName, name, "prefix/", "value1", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketAnalyticsConfigurationExists(resourceName, &ac),
+					resource.TestCheckResourceAttr(resourceName, "filter.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "filter.0.prefix", "prefix/"),
+					resource.TestCheckResourceAttr(resourceName, "filter.0.tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "filter.0.tags.tag1", "value1"),
+					resource.TestCheckResourceAttr(resourceName, "filter.0.tags.tag2", "value2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSS3BucketAnalyticsConfiguration_WithStorageClassAnalysis(t *testing.T) {
+	var ac s3.AnalyticsConfiguration
+	rInt := acctest.RandInt()
+	resourceName := "aws_s3_bucket_analytics_configuration.test"
+	bucketName := fmt.Sprintf("tf-acc-%d", rInt)
+	name := t.Name()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSS3BucketAnalyticsConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSS3BucketAnalyticsConfigurationWithStorageClassAnalysis(bucketName, name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketAnalyticsConfigurationExists(resourceName, &ac),
+					resource.TestCheckResourceAttr(resourceName, "storage_class_analysis.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "storage_class_analysis.0.data_export.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "storage_class_analysis.0.data_export.0.output_schema_version", "V_1"),
+					resource.TestCheckResourceAttr(resourceName, "storage_class_analysis.0.data_export.0.destination.0.s3_bucket_destination.0.format", "CSV"),
+					resource.TestCheckResourceAttr(resourceName, "storage_class_analysis.0.data_export.0.destination.0.s3_bucket_destination.0.prefix", "analytics"),
+					resource.TestCheckResourceAttrPair(resourceName, "storage_class_analysis.0.data_export.0.destination.0.s3_bucket_destination.0.bucket_arn", "aws_s3_bucket.destination", "arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "storage_class_analysis.0.data_export.0.destination.0.s3_bucket_destination.0.bucket_account_id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSS3BucketAnalyticsConfigurationExists(n string, ac *s3.AnalyticsConfiguration) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No S3 bucket analytics configuration ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).s3conn
+		bucket, name, err := resourceAwsS3BucketAnalyticsConfigurationParseID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		output, err := conn.GetBucketAnalyticsConfiguration(&s3.GetBucketAnalyticsConfigurationInput{
+			Bucket: aws.String(bucket),
+			Id:     aws.String(name),
+		})
+		if err != nil {
+			return err
+		}
+		if output.AnalyticsConfiguration == nil {
+			return fmt.Errorf("S3 bucket analytics configuration %s not found", rs.Primary.ID)
+		}
+
+		*ac = *output.AnalyticsConfiguration
+
+		return nil
+	}
+}
+
+func testAccCheckAWSS3BucketAnalyticsConfigurationDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).s3conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_s3_bucket_analytics_configuration" {
+			continue
+		}
+
+		bucket, name, err := resourceAwsS3BucketAnalyticsConfigurationParseID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		err = resource.Retry(1*time.Minute, func() *resource.RetryError {
+			input := &s3.GetBucketAnalyticsConfigurationInput{
+				Bucket: aws.String(bucket),
+				Id:     aws.String(name),
+			}
+			log.Printf("[DEBUG] Reading S3 bucket analytics configuration: %s", input)
+			output, err := conn.GetBucketAnalyticsConfiguration(input)
+			if err != nil {
+				if isAWSErr(err, s3.ErrCodeNoSuchBucket, "") || isAWSErr(err, "NoSuchConfiguration", "") {
+					return nil
+				}
+				return resource.NonRetryableError(err)
+			}
+			if output.AnalyticsConfiguration != nil {
+				return resource.RetryableError(fmt.Errorf("S3 bucket analytics configuration exists: %v", output))
+			}
+
+			return nil
+		})
+
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func testAccAWSS3BucketAnalyticsConfigurationConfigBucket(name string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = "%s"
+}
+`, name)
+}
+
+func testAccAWSS3BucketAnalyticsConfigurationConfig(bucketName, name string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "aws_s3_bucket_analytics_configuration" "test" {
+  bucket = "${aws_s3_bucket.test.bucket}"
+  name   = "%s"
+}
+`, testAccAWSS3BucketAnalyticsConfigurationConfigBucket(bucketName), name)
+}
+
+func testAccAWSS3BucketAnalyticsConfigurationWithFilterPrefix(bucketName, name, prefix string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "aws_s3_bucket_analytics_configuration" "test" {
+  bucket = "${aws_s3_bucket.test.bucket}"
+  name   = "%s"
+
+  filter {
+    prefix = "%s"
+  }
+}
+`, testAccAWSS3BucketAnalyticsConfigurationConfigBucket(bucketName), name, prefix)
+}
+
+func testAccAWSS3BucketAnalyticsConfigurationWithFilterPrefixAndTags(bucketName, name, prefix, tag1, tag2 string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "aws_s3_bucket_analytics_configuration" "test" {
+  bucket = "${aws_s3_bucket.test.bucket}"
+  name   = "%s"
+
+  filter {
+    prefix = "%s"
+
+    tags {
+      "tag1" = "%s"
+      "tag2" = "%s"
+    }
+  }
+}
+`, testAccAWSS3BucketAnalyticsConfigurationConfigBucket(bucketName), name, prefix, tag1, tag2)
+}
+
+func testAccAWSS3BucketAnalyticsConfigurationWithStorageClassAnalysis(bucketName, name string) string {
+	return fmt.Sprintf(`
+%s
+
+data "aws_caller_identity" "current" {}
+
+resource "aws_s3_bucket" "destination" {
+  bucket = "%s-destination"
+}
+
+resource "aws_s3_bucket_analytics_configuration" "test" {
+  bucket = "${aws_s3_bucket.test.bucket}"
+  name   = "%s"
+
+  storage_class_analysis {
+    data_export {
+      destination {
+        s3_bucket_destination {
+          bucket_arn        = "${aws_s3_bucket.destination.arn}"
+          bucket_account_id = "${data.aws_caller_identity.current.account_id}"
+          prefix            = "analytics"
+        }
+      }
+    }
+  }
+}
+`, testAccAWSS3BucketAnalyticsConfigurationConfigBucket(bucketName), bucketName, name)
+}
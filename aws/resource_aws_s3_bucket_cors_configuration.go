@@ -0,0 +1,114 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsS3BucketCorsConfiguration() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsS3BucketCorsConfigurationPut,
+		Read:   resourceAwsS3BucketCorsConfigurationRead,
+		Update: resourceAwsS3BucketCorsConfigurationPut,
+		Delete: resourceAwsS3BucketCorsConfigurationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"cors_rule": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 100,
+				Elem:     s3BucketCorsRuleResource(),
+			},
+		},
+	}
+}
+
+func resourceAwsS3BucketCorsConfigurationPut(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).s3conn
+	bucket := d.Get("bucket").(string)
+
+	input := &s3.PutBucketCorsInput{
+		Bucket: aws.String(bucket),
+		CORSConfiguration: &s3.CORSConfiguration{
+			CORSRules: expandS3CorsRules(d.Get("cors_rule").([]interface{})),
+		},
+	}
+
+	log.Printf("[DEBUG] Putting S3 bucket CORS configuration: %s", input)
+	err := resource.Retry(1*time.Minute, func() *resource.RetryError {
+		_, err := conn.PutBucketCors(input)
+		if err != nil {
+			if isAWSErr(err, s3.ErrCodeNoSuchBucket, "") {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("Error putting S3 bucket CORS configuration: %s", err)
+	}
+
+	d.SetId(bucket)
+
+	return resourceAwsS3BucketCorsConfigurationRead(d, meta)
+}
+
+func resourceAwsS3BucketCorsConfigurationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).s3conn
+
+	input := &s3.GetBucketCorsInput{
+		Bucket: aws.String(d.Id()),
+	}
+
+	log.Printf("[DEBUG] Reading S3 bucket CORS configuration: %s", input)
+	output, err := conn.GetBucketCors(input)
+	if err != nil {
+		if isAWSErr(err, s3.ErrCodeNoSuchBucket, "") || isAWSErr(err, "NoSuchCORSConfiguration", "") {
+			log.Printf("[WARN] %s S3 bucket CORS configuration not found, removing from state.", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading S3 bucket CORS configuration: %s", err)
+	}
+
+	d.Set("bucket", d.Id())
+	if err := d.Set("cors_rule", flattenS3CorsRules(output.CORSRules)); err != nil {
+		return fmt.Errorf("error setting cors_rule: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsS3BucketCorsConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).s3conn
+
+	input := &s3.DeleteBucketCorsInput{
+		Bucket: aws.String(d.Id()),
+	}
+
+	log.Printf("[DEBUG] Deleting S3 bucket CORS configuration: %s", input)
+	_, err := conn.DeleteBucketCors(input)
+	if err != nil {
+		if isAWSErr(err, s3.ErrCodeNoSuchBucket, "") {
+			return nil
+		}
+		return fmt.Errorf("Error deleting S3 bucket CORS configuration: %s", err)
+	}
+
+	return nil
+}
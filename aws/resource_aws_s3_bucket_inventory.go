@@ -0,0 +1,445 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsS3BucketInventory() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsS3BucketInventoryPut,
+		Read:   resourceAwsS3BucketInventoryRead,
+		Update: resourceAwsS3BucketInventoryPut,
+		Delete: resourceAwsS3BucketInventoryDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Default:  true,
+				Optional: true,
+			},
+			"filter": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"prefix": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"destination": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"bucket": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"format": {
+										Type:     schema.TypeString,
+										Required: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											s3.InventoryFormatCsv,
+											s3.InventoryFormatOrc,
+										}, false),
+									},
+									"bucket_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validateArn,
+									},
+									"account_id": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validateAwsAccountId,
+									},
+									"prefix": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"encryption": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"sse_kms": {
+													Type:          schema.TypeList,
+													Optional:      true,
+													MaxItems:      1,
+													ConflictsWith: []string{"destination.0.bucket.0.encryption.0.sse_s3"},
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"key_id": {
+																Type:         schema.TypeString,
+																Required:     true,
+																ValidateFunc: validateArn,
+															},
+														},
+													},
+												},
+												"sse_s3": {
+													Type:          schema.TypeList,
+													Optional:      true,
+													MaxItems:      1,
+													ConflictsWith: []string{"destination.0.bucket.0.encryption.0.sse_kms"},
+													Elem: &schema.Resource{
+														// No options currently; just existence of "sse_s3".
+														Schema: map[string]*schema.Schema{},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"schedule": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"frequency": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								s3.InventoryFrequencyDaily,
+								s3.InventoryFrequencyWeekly,
+							}, false),
+						},
+					},
+				},
+			},
+			"included_object_versions": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					s3.InventoryIncludedObjectVersionsAll,
+					s3.InventoryIncludedObjectVersionsCurrent,
+				}, false),
+			},
+			"optional_fields": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{
+						s3.InventoryOptionalFieldSize,
+						s3.InventoryOptionalFieldLastModifiedDate,
+						s3.InventoryOptionalFieldStorageClass,
+						s3.InventoryOptionalFieldEtag,
+						s3.InventoryOptionalFieldIsMultipartUploaded,
+						s3.InventoryOptionalFieldReplicationStatus,
+						s3.InventoryOptionalFieldEncryptionStatus,
+					}, false),
+				},
+				Set: schema.HashString,
+			},
+		},
+	}
+}
+
+func resourceAwsS3BucketInventoryPut(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).s3conn
+	bucket := d.Get("bucket").(string)
+	name := d.Get("name").(string)
+
+	inventoryConfiguration := &s3.InventoryConfiguration{
+		Id:                     aws.String(name),
+		IsEnabled:              aws.Bool(d.Get("enabled").(bool)),
+		IncludedObjectVersions: aws.String(d.Get("included_object_versions").(string)),
+	}
+
+	if v, ok := d.GetOk("optional_fields"); ok {
+		inventoryConfiguration.OptionalFields = expandStringList(v.(*schema.Set).List())
+	}
+
+	if v, ok := d.GetOk("schedule"); ok {
+		scheduleList := v.([]interface{})
+		scheduleMap := scheduleList[0].(map[string]interface{})
+		inventoryConfiguration.Schedule = &s3.InventorySchedule{
+			Frequency: aws.String(scheduleMap["frequency"].(string)),
+		}
+	}
+
+	if v, ok := d.GetOk("filter"); ok {
+		filterList := v.([]interface{})
+		if filterMap, ok := filterList[0].(map[string]interface{}); ok {
+			inventoryConfiguration.Filter = expandS3InventoryFilter(filterMap)
+		}
+	}
+
+	if v, ok := d.GetOk("destination"); ok {
+		destinationList := v.([]interface{})
+		destinationMap := destinationList[0].(map[string]interface{})
+		bucketList := destinationMap["bucket"].([]interface{})
+		bucketMap := bucketList[0].(map[string]interface{})
+
+		inventoryConfiguration.Destination = &s3.InventoryDestination{
+			S3BucketDestination: expandS3InventoryS3BucketDestination(bucketMap),
+		}
+	}
+
+	input := &s3.PutBucketInventoryConfigurationInput{
+		Bucket:                 aws.String(bucket),
+		Id:                     aws.String(name),
+		InventoryConfiguration: inventoryConfiguration,
+	}
+
+	log.Printf("[DEBUG] Putting S3 bucket inventory configuration: %s", input)
+	err := resource.Retry(1*time.Minute, func() *resource.RetryError {
+		_, err := conn.PutBucketInventoryConfiguration(input)
+		if err != nil {
+			if isAWSErr(err, s3.ErrCodeNoSuchBucket, "") {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("Error putting S3 bucket inventory configuration: %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", bucket, name))
+
+	return resourceAwsS3BucketInventoryRead(d, meta)
+}
+
+func resourceAwsS3BucketInventoryDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).s3conn
+
+	bucket, name, err := resourceAwsS3BucketInventoryParseID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	input := &s3.DeleteBucketInventoryConfigurationInput{
+		Bucket: aws.String(bucket),
+		Id:     aws.String(name),
+	}
+
+	log.Printf("[DEBUG] Deleting S3 bucket inventory configuration: %s", input)
+	_, err = conn.DeleteBucketInventoryConfiguration(input)
+	if err != nil {
+		if isAWSErr(err, s3.ErrCodeNoSuchBucket, "") || isAWSErr(err, "NoSuchConfiguration", "") {
+			log.Printf("[WARN] %s S3 bucket inventory configuration not found, removing from state.", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error deleting S3 bucket inventory configuration: %s", err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceAwsS3BucketInventoryRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).s3conn
+
+	bucket, name, err := resourceAwsS3BucketInventoryParseID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	d.Set("bucket", bucket)
+	d.Set("name", name)
+
+	input := &s3.GetBucketInventoryConfigurationInput{
+		Bucket: aws.String(bucket),
+		Id:     aws.String(name),
+	}
+
+	log.Printf("[DEBUG] Reading S3 bucket inventory configuration: %s", input)
+	output, err := conn.GetBucketInventoryConfiguration(input)
+	if err != nil {
+		if isAWSErr(err, s3.ErrCodeNoSuchBucket, "") || isAWSErr(err, "NoSuchConfiguration", "") {
+			log.Printf("[WARN] %s S3 bucket inventory configuration not found, removing from state.", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	if output.InventoryConfiguration == nil {
+		log.Printf("[WARN] %s S3 bucket inventory configuration not found, removing from state.", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	inventoryConfiguration := output.InventoryConfiguration
+
+	d.Set("enabled", inventoryConfiguration.IsEnabled)
+	d.Set("included_object_versions", inventoryConfiguration.IncludedObjectVersions)
+
+	if err := d.Set("optional_fields", flattenStringList(inventoryConfiguration.OptionalFields)); err != nil {
+		return fmt.Errorf("Error setting optional_fields: %s", err)
+	}
+
+	if err := d.Set("filter", flattenS3InventoryFilter(inventoryConfiguration.Filter)); err != nil {
+		return fmt.Errorf("Error setting filter: %s", err)
+	}
+
+	schedule := []interface{}{}
+	if inventoryConfiguration.Schedule != nil {
+		schedule = append(schedule, map[string]interface{}{
+			"frequency": aws.StringValue(inventoryConfiguration.Schedule.Frequency),
+		})
+	}
+	if err := d.Set("schedule", schedule); err != nil {
+		return fmt.Errorf("Error setting schedule: %s", err)
+	}
+
+	destination := []interface{}{}
+	if inventoryConfiguration.Destination != nil && inventoryConfiguration.Destination.S3BucketDestination != nil {
+		destination = append(destination, map[string]interface{}{
+			"bucket": []interface{}{
+				flattenS3InventoryS3BucketDestination(inventoryConfiguration.Destination.S3BucketDestination),
+			},
+		})
+	}
+	if err := d.Set("destination", destination); err != nil {
+		return fmt.Errorf("Error setting destination: %s", err)
+	}
+
+	return nil
+}
+
+func expandS3InventoryFilter(m map[string]interface{}) *s3.InventoryFilter {
+	v, ok := m["prefix"]
+	if !ok || v.(string) == "" {
+		return nil
+	}
+	return &s3.InventoryFilter{
+		Prefix: aws.String(v.(string)),
+	}
+}
+
+func flattenS3InventoryFilter(filter *s3.InventoryFilter) []interface{} {
+	if filter == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"prefix": aws.StringValue(filter.Prefix),
+		},
+	}
+}
+
+func expandS3InventoryS3BucketDestination(m map[string]interface{}) *s3.InventoryS3BucketDestination {
+	destination := &s3.InventoryS3BucketDestination{
+		Format: aws.String(m["format"].(string)),
+		Bucket: aws.String(m["bucket_arn"].(string)),
+	}
+
+	if v, ok := m["account_id"]; ok && v.(string) != "" {
+		destination.AccountId = aws.String(v.(string))
+	}
+
+	if v, ok := m["prefix"]; ok && v.(string) != "" {
+		destination.Prefix = aws.String(v.(string))
+	}
+
+	if v, ok := m["encryption"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		destination.Encryption = expandS3InventoryEncryption(v[0].(map[string]interface{}))
+	}
+
+	return destination
+}
+
+func flattenS3InventoryS3BucketDestination(destination *s3.InventoryS3BucketDestination) map[string]interface{} {
+	m := map[string]interface{}{
+		"format":     aws.StringValue(destination.Format),
+		"bucket_arn": aws.StringValue(destination.Bucket),
+	}
+
+	if destination.AccountId != nil {
+		m["account_id"] = aws.StringValue(destination.AccountId)
+	}
+	if destination.Prefix != nil {
+		m["prefix"] = aws.StringValue(destination.Prefix)
+	}
+	if destination.Encryption != nil {
+		m["encryption"] = []interface{}{flattenS3InventoryEncryption(destination.Encryption)}
+	}
+
+	return m
+}
+
+func expandS3InventoryEncryption(m map[string]interface{}) *s3.InventoryEncryption {
+	encryption := &s3.InventoryEncryption{}
+
+	if v, ok := m["sse_kms"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		kms := v[0].(map[string]interface{})
+		encryption.SSEKMS = &s3.SSEKMS{
+			KeyId: aws.String(kms["key_id"].(string)),
+		}
+	} else if v, ok := m["sse_s3"].([]interface{}); ok && len(v) > 0 {
+		encryption.SSES3 = &s3.SSES3{}
+	}
+
+	return encryption
+}
+
+func flattenS3InventoryEncryption(encryption *s3.InventoryEncryption) map[string]interface{} {
+	m := make(map[string]interface{})
+
+	if encryption.SSEKMS != nil {
+		m["sse_kms"] = []interface{}{
+			map[string]interface{}{
+				"key_id": aws.StringValue(encryption.SSEKMS.KeyId),
+			},
+		}
+	} else if encryption.SSES3 != nil {
+		m["sse_s3"] = []interface{}{
+			map[string]interface{}{},
+		}
+	}
+
+	return m
+}
+
+func resourceAwsS3BucketInventoryParseID(id string) (string, string, error) {
+	idParts := strings.Split(id, ":")
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		return "", "", fmt.Errorf("please make sure the ID is in the form BUCKET:NAME (i.e. my-bucket:EntireBucket")
+	}
+	bucket := idParts[0]
+	name := idParts[1]
+	return bucket, name, nil
+}
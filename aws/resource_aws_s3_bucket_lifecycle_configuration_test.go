@@ -0,0 +1,331 @@
+package aws
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestExpandS3LifecycleRules(t *testing.T) {
+	raw := []interface{}{
+		map[string]interface{}{
+			"id":                                     "logs",
+			"prefix":                                 "logs/",
+			"tags":                                   map[string]interface{}{},
+			"enabled":                                true,
+			"abort_incomplete_multipart_upload_days": 7,
+			"expiration": schema.NewSet(expirationHash, []interface{}{
+				map[string]interface{}{
+					"date":                         "",
+					"days":                         90,
+					"expired_object_delete_marker": false,
+				},
+			}),
+			"noncurrent_version_expiration": schema.NewSet(expirationHash, nil),
+			"transition": schema.NewSet(transitionHash, []interface{}{
+				map[string]interface{}{
+					"date":          "",
+					"days":          30,
+					"storage_class": "STANDARD_IA",
+				},
+			}),
+			"noncurrent_version_transition": schema.NewSet(transitionHash, nil),
+		},
+	}
+
+	rules, err := expandS3LifecycleRules(raw)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(rules) != 1 {
+		t.Fatalf("expected 1 rule, got %d", len(rules))
+	}
+
+	rule := rules[0]
+	if v := aws.StringValue(rule.ID); v != "logs" {
+		t.Errorf("expected ID %q, got %q", "logs", v)
+	}
+	if v := aws.StringValue(rule.Filter.Prefix); v != "logs/" {
+		t.Errorf("expected prefix %q, got %q", "logs/", v)
+	}
+	if v := aws.StringValue(rule.Status); v != s3.ExpirationStatusEnabled {
+		t.Errorf("expected status %q, got %q", s3.ExpirationStatusEnabled, v)
+	}
+	if v := aws.Int64Value(rule.AbortIncompleteMultipartUpload.DaysAfterInitiation); v != 7 {
+		t.Errorf("expected abort days 7, got %d", v)
+	}
+	if v := aws.Int64Value(rule.Expiration.Days); v != 90 {
+		t.Errorf("expected expiration days 90, got %d", v)
+	}
+	if len(rule.Transitions) != 1 || aws.StringValue(rule.Transitions[0].StorageClass) != "STANDARD_IA" {
+		t.Errorf("unexpected transitions: %v", rule.Transitions)
+	}
+
+	flattened := flattenS3LifecycleRules(rules)
+	if len(flattened) != 1 {
+		t.Fatalf("expected 1 flattened rule, got %d", len(flattened))
+	}
+	if v := flattened[0]["prefix"]; v != "logs/" {
+		t.Errorf("expected flattened prefix %q, got %q", "logs/", v)
+	}
+	if v := flattened[0]["expiration"].(*schema.Set); v.Len() != 1 {
+		t.Errorf("expected 1 flattened expiration, got %d", v.Len())
+	}
+}
+
+func TestExpandS3LifecycleRuleFilter(t *testing.T) {
+	testCases := []struct {
+		Prefix         string
+		Tags           map[string]interface{}
+		ExpectedFilter *s3.LifecycleRuleFilter
+	}{
+		{
+			Prefix: "",
+			Tags:   map[string]interface{}{},
+			ExpectedFilter: &s3.LifecycleRuleFilter{
+				Prefix: aws.String(""),
+			},
+		},
+		{
+			Prefix: "logs/",
+			Tags:   map[string]interface{}{},
+			ExpectedFilter: &s3.LifecycleRuleFilter{
+				Prefix: aws.String("logs/"),
+			},
+		},
+		{
+			Prefix: "",
+			Tags: map[string]interface{}{
+				"retention": "short",
+			},
+			ExpectedFilter: &s3.LifecycleRuleFilter{
+				Tag: &s3.Tag{
+					Key:   aws.String("retention"),
+					Value: aws.String("short"),
+				},
+			},
+		},
+		{
+			Prefix: "logs/",
+			Tags: map[string]interface{}{
+				"retention": "short",
+			},
+			ExpectedFilter: &s3.LifecycleRuleFilter{
+				And: &s3.LifecycleRuleAndOperator{
+					Prefix: aws.String("logs/"),
+					Tags: []*s3.Tag{
+						&s3.Tag{
+							Key:   aws.String("retention"),
+							Value: aws.String("short"),
+						},
+					},
+				},
+			},
+		},
+		{
+			Prefix: "",
+			Tags: map[string]interface{}{
+				"retention": "short",
+				"team":      "data",
+			},
+			ExpectedFilter: &s3.LifecycleRuleFilter{
+				And: &s3.LifecycleRuleAndOperator{
+					Tags: []*s3.Tag{
+						&s3.Tag{
+							Key:   aws.String("retention"),
+							Value: aws.String("short"),
+						},
+						&s3.Tag{
+							Key:   aws.String("team"),
+							Value: aws.String("data"),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for i, tc := range testCases {
+		value := expandS3LifecycleRuleFilter(tc.Prefix, tc.Tags)
+
+		// Sort tags by key for consistency
+		if value.And != nil && value.And.Tags != nil {
+			sort.Slice(value.And.Tags, func(i, j int) bool {
+				return *value.And.Tags[i].Key < *value.And.Tags[j].Key
+			})
+		}
+
+		// Convert to strings to avoid dealing with pointers
+		valueS := fmt.Sprintf("%v", value)
+		expectedValueS := fmt.Sprintf("%v", tc.ExpectedFilter)
+
+		if valueS != expectedValueS {
+			t.Fatalf("Case #%d: Given:\n%s\n\nExpected:\n%s", i, valueS, expectedValueS)
+		}
+	}
+}
+
+func TestFlattenS3LifecycleRuleFilter(t *testing.T) {
+	testCases := []struct {
+		LifecycleRule  *s3.LifecycleRule
+		ExpectedPrefix string
+		ExpectedTags   map[string]string
+	}{
+		{
+			LifecycleRule: &s3.LifecycleRule{
+				Prefix: aws.String("legacy/"),
+			},
+			ExpectedPrefix: "legacy/",
+		},
+		{
+			LifecycleRule: &s3.LifecycleRule{
+				Filter: &s3.LifecycleRuleFilter{
+					Prefix: aws.String("logs/"),
+				},
+			},
+			ExpectedPrefix: "logs/",
+		},
+		{
+			LifecycleRule: &s3.LifecycleRule{
+				Filter: &s3.LifecycleRuleFilter{
+					Tag: &s3.Tag{
+						Key:   aws.String("retention"),
+						Value: aws.String("short"),
+					},
+				},
+			},
+			ExpectedTags: map[string]string{
+				"retention": "short",
+			},
+		},
+		{
+			LifecycleRule: &s3.LifecycleRule{
+				Filter: &s3.LifecycleRuleFilter{
+					And: &s3.LifecycleRuleAndOperator{
+						Prefix: aws.String("logs/"),
+						Tags: []*s3.Tag{
+							&s3.Tag{
+								Key:   aws.String("retention"),
+								Value: aws.String("short"),
+							},
+							&s3.Tag{
+								Key:   aws.String("team"),
+								Value: aws.String("data"),
+							},
+						},
+					},
+				},
+			},
+			ExpectedPrefix: "logs/",
+			ExpectedTags: map[string]string{
+				"retention": "short",
+				"team":      "data",
+			},
+		},
+	}
+
+	for i, tc := range testCases {
+		prefix, tags := flattenS3LifecycleRuleFilter(tc.LifecycleRule)
+
+		if prefix != tc.ExpectedPrefix {
+			t.Fatalf("Case #%d: expected prefix %q, got %q", i, tc.ExpectedPrefix, prefix)
+		}
+		if len(tags) != len(tc.ExpectedTags) || (len(tags) > 0 && !reflect.DeepEqual(tags, tc.ExpectedTags)) {
+			t.Fatalf("Case #%d: expected tags %v, got %v", i, tc.ExpectedTags, tags)
+		}
+	}
+}
+
+func TestAccAWSS3BucketLifecycleConfiguration_basic(t *testing.T) {
+	rInt := acctest.RandInt()
+	resourceName := "aws_s3_bucket_lifecycle_configuration.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSS3BucketLifecycleConfigurationDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccAWSS3BucketLifecycleConfigurationConfig(rInt, 90),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "bucket", testAccBucketName(rInt)),
+					resource.TestCheckResourceAttr(resourceName, "rule.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.id", "logs"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.prefix", "logs/"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.expiration.#", "1"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccAWSS3BucketLifecycleConfigurationConfig(rInt, 365),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "rule.#", "1"),
+				),
+			},
+			resource.TestStep{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSS3BucketLifecycleConfigurationDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).s3conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_s3_bucket_lifecycle_configuration" {
+			continue
+		}
+
+		_, err := conn.GetBucketLifecycleConfiguration(&s3.GetBucketLifecycleConfigurationInput{
+			Bucket: aws.String(rs.Primary.ID),
+		})
+		if err == nil {
+			return fmt.Errorf("S3 bucket lifecycle configuration still exists: %s", rs.Primary.ID)
+		}
+		if !isAWSErr(err, s3.ErrCodeNoSuchBucket, "") && !isAWSErr(err, "NoSuchLifecycleConfiguration", "") {
+			return err
+		}
+	}
+	return nil
+}
+
+func testAccAWSS3BucketLifecycleConfigurationConfig(randInt, days int) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = "tf-test-bucket-%d"
+
+  lifecycle {
+    ignore_changes = ["lifecycle_rule"]
+  }
+}
+
+resource "aws_s3_bucket_lifecycle_configuration" "test" {
+  bucket = "${aws_s3_bucket.test.id}"
+
+  rule {
+    id      = "logs"
+    prefix  = "logs/"
+    enabled = true
+
+    transition {
+      days          = 30
+      storage_class = "STANDARD_IA"
+    }
+
+    expiration {
+      days = %d
+    }
+  }
+}
+`, randInt, days)
+}
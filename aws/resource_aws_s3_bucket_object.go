@@ -2,6 +2,8 @@ package aws
 
 import (
 	"bytes"
+	"crypto/md5"
+	"encoding/hex"
 	"fmt"
 	"io"
 	"log"
@@ -11,6 +13,7 @@ import (
 	"strings"
 
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/mitchellh/go-homedir"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -23,7 +26,7 @@ func resourceAwsS3BucketObject() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceAwsS3BucketObjectPut,
 		Read:   resourceAwsS3BucketObjectRead,
-		Update: resourceAwsS3BucketObjectPut,
+		Update: resourceAwsS3BucketObjectUpdate,
 		Delete: resourceAwsS3BucketObjectDelete,
 
 		Schema: map[string]*schema.Schema{
@@ -66,6 +69,12 @@ func resourceAwsS3BucketObject() *schema.Resource {
 				Computed: true,
 			},
 
+			"metadata": {
+				Type:         schema.TypeMap,
+				Optional:     true,
+				ValidateFunc: validateS3BucketObjectMetadata,
+			},
+
 			"key": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -119,6 +128,25 @@ func resourceAwsS3BucketObject() *schema.Resource {
 				Computed: true,
 			},
 
+			"multipart_threshold": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      s3MultipartDefaultPartSize,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+
+			"multipart_part_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      s3MultipartDefaultPartSize,
+				ValidateFunc: validation.IntAtLeast(s3MultipartMinPartSize),
+			},
+
+			"multipart_etag": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
 			"tags": tagsSchema(),
 
 			"website_redirect": {
@@ -134,7 +162,8 @@ func resourceAwsS3BucketObjectPut(d *schema.ResourceData, meta interface{}) erro
 
 	restricted := meta.(*AWSClient).IsChinaCloud()
 
-	var body io.ReadSeeker
+	var body s3UploadBody
+	var size int64
 
 	if v, ok := d.GetOk("source"); ok {
 		source := v.(string)
@@ -146,11 +175,19 @@ func resourceAwsS3BucketObjectPut(d *schema.ResourceData, meta interface{}) erro
 		if err != nil {
 			return fmt.Errorf("Error opening S3 bucket object source (%s): %s", source, err)
 		}
+		defer file.Close()
+
+		info, err := file.Stat()
+		if err != nil {
+			return fmt.Errorf("Error reading S3 bucket object source (%s): %s", source, err)
+		}
 
 		body = file
+		size = info.Size()
 	} else if v, ok := d.GetOk("content"); ok {
 		content := v.(string)
 		body = bytes.NewReader([]byte(content))
+		size = int64(len(content))
 	} else {
 		return fmt.Errorf("Must specify \"source\" or \"content\" field")
 	}
@@ -162,7 +199,6 @@ func resourceAwsS3BucketObjectPut(d *schema.ResourceData, meta interface{}) erro
 		Bucket: aws.String(bucket),
 		Key:    aws.String(key),
 		ACL:    aws.String(d.Get("acl").(string)),
-		Body:   body,
 	}
 
 	if v, ok := d.GetOk("storage_class"); ok {
@@ -189,6 +225,10 @@ func resourceAwsS3BucketObjectPut(d *schema.ResourceData, meta interface{}) erro
 		putInput.ContentDisposition = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("metadata"); ok {
+		putInput.Metadata = stringMapToPointers(v.(map[string]interface{}))
+	}
+
 	if v, ok := d.GetOk("server_side_encryption"); ok {
 		putInput.ServerSideEncryption = aws.String(v.(string))
 	}
@@ -215,13 +255,38 @@ func resourceAwsS3BucketObjectPut(d *schema.ResourceData, meta interface{}) erro
 		putInput.WebsiteRedirectLocation = aws.String(v.(string))
 	}
 
-	resp, err := s3conn.PutObject(putInput)
+	threshold := int64(d.Get("multipart_threshold").(int))
+	partSize := int64(d.Get("multipart_part_size").(int))
+	multipart := size > threshold
+
+	// The ETag of an object uploaded in parts isn't the MD5 of its content,
+	// which is what etag is compared against.
+	var contentMD5 string
+	if multipart {
+		h := md5.New()
+		if _, err := io.Copy(h, body); err != nil {
+			return fmt.Errorf("Error reading S3 bucket object body: %s", err)
+		}
+		if _, err := body.Seek(0, io.SeekStart); err != nil {
+			return fmt.Errorf("Error reading S3 bucket object body: %s", err)
+		}
+		contentMD5 = hex.EncodeToString(h.Sum(nil))
+	}
+
+	resp, err := putS3Object(s3conn, putInput, body, size, threshold, partSize)
 	if err != nil {
 		return fmt.Errorf("Error putting object in S3 bucket (%s): %s", bucket, err)
 	}
 
 	// See https://forums.aws.amazon.com/thread.jspa?threadID=44003
-	d.Set("etag", strings.Trim(*resp.ETag, `"`))
+	etag := strings.Trim(aws.StringValue(resp.ETag), `"`)
+	if multipart {
+		d.Set("etag", contentMD5)
+		d.Set("multipart_etag", etag)
+	} else {
+		d.Set("etag", etag)
+		d.Set("multipart_etag", "")
+	}
 
 	d.Set("version_id", resp.VersionId)
 	d.SetId(key)
@@ -258,6 +323,7 @@ func resourceAwsS3BucketObjectRead(d *schema.ResourceData, meta interface{}) err
 	d.Set("content_encoding", resp.ContentEncoding)
 	d.Set("content_language", resp.ContentLanguage)
 	d.Set("content_type", resp.ContentType)
+	d.Set("metadata", flattenS3ObjectMetadata(resp.Metadata))
 	d.Set("version_id", resp.VersionId)
 	d.Set("server_side_encryption", resp.ServerSideEncryption)
 	d.Set("website_redirect", resp.WebsiteRedirectLocation)
@@ -278,7 +344,18 @@ func resourceAwsS3BucketObjectRead(d *schema.ResourceData, meta interface{}) err
 			d.Set("kms_key_id", resp.SSEKMSKeyId)
 		}
 	}
-	d.Set("etag", strings.Trim(*resp.ETag, `"`))
+
+	// Objects uploaded in parts keep the MD5 computed at upload time as etag
+	// for as long as they are unchanged. Anything else, such as an object
+	// replaced outside of Terraform, shows up as a change of etag.
+	etag := strings.Trim(aws.StringValue(resp.ETag), `"`)
+	if etag != d.Get("multipart_etag").(string) {
+		d.Set("etag", etag)
+		d.Set("multipart_etag", "")
+		if strings.Contains(etag, "-") {
+			d.Set("multipart_etag", etag)
+		}
+	}
 
 	// The "STANDARD" (which is also the default) storage
 	// class when set would not be included in the results.
@@ -302,6 +379,45 @@ func resourceAwsS3BucketObjectRead(d *schema.ResourceData, meta interface{}) err
 	return nil
 }
 
+// s3BucketObjectUploadAttributes are the arguments which can only be changed
+// by uploading the object again. Everything else is updated in place.
+var s3BucketObjectUploadAttributes = []string{
+	"acl",
+	"cache_control",
+	"content",
+	"content_disposition",
+	"content_encoding",
+	"content_language",
+	"content_type",
+	"etag",
+	"kms_key_id",
+	"metadata",
+	"server_side_encryption",
+	"source",
+	"storage_class",
+	"website_redirect",
+}
+
+func resourceAwsS3BucketObjectUpdate(d *schema.ResourceData, meta interface{}) error {
+	for _, k := range s3BucketObjectUploadAttributes {
+		if d.HasChange(k) {
+			return resourceAwsS3BucketObjectPut(d, meta)
+		}
+	}
+
+	if d.HasChange("tags") {
+		if meta.(*AWSClient).IsChinaCloud() {
+			return fmt.Errorf("This region does not allow for tags on S3 objects")
+		}
+
+		if err := setObjectTagsS3(meta.(*AWSClient).s3conn, d); err != nil {
+			return err
+		}
+	}
+
+	return resourceAwsS3BucketObjectRead(d, meta)
+}
+
 func resourceAwsS3BucketObjectDelete(d *schema.ResourceData, meta interface{}) error {
 	s3conn := meta.(*AWSClient).s3conn
 
@@ -346,6 +462,27 @@ func resourceAwsS3BucketObjectDelete(d *schema.ResourceData, meta interface{}) e
 	return nil
 }
 
+// flattenS3ObjectMetadata returns object metadata keyed by the names it was
+// stored with. The SDK canonicalizes the x-amz-meta-* header names it reads
+// (e.g. "Foo-Bar"), whereas S3 itself always stores them in lower case.
+func flattenS3ObjectMetadata(metadata map[string]*string) map[string]interface{} {
+	m := make(map[string]interface{}, len(metadata))
+	for k, v := range metadata {
+		m[strings.ToLower(k)] = aws.StringValue(v)
+	}
+	return m
+}
+
+func validateS3BucketObjectMetadata(v interface{}, k string) (ws []string, errors []error) {
+	for key := range v.(map[string]interface{}) {
+		if key != strings.ToLower(key) {
+			errors = append(errors, fmt.Errorf(
+				"%q: metadata key %q must be lower case, as S3 stores metadata keys in lower case", k, key))
+		}
+	}
+	return
+}
+
 func validateS3BucketObjectAclType(v interface{}, k string) (ws []string, errors []error) {
 	value := v.(string)
 
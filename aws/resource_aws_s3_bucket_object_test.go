@@ -1,6 +1,8 @@
 package aws
 
 import (
+	"bytes"
+	"crypto/md5"
 	"fmt"
 	"io/ioutil"
 	"os"
@@ -140,6 +142,52 @@ func TestAccAWSS3BucketObject_updates(t *testing.T) {
 	})
 }
 
+func TestAccAWSS3BucketObject_multipart(t *testing.T) {
+	tmpFile, err := ioutil.TempFile("", "tf-acc-s3-obj-multipart")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(tmpFile.Name())
+
+	// Two full parts and a short last one.
+	content := bytes.Repeat([]byte("0123456789"), (2*s3MultipartMinPartSize+1024)/10)
+	if err := ioutil.WriteFile(tmpFile.Name(), content, 0644); err != nil {
+		t.Fatal(err)
+	}
+	contentMD5 := fmt.Sprintf("%x", md5.Sum(content))
+	multipartEtag, err := s3ObjectEtag(bytes.NewReader(content), int64(len(content)), s3MultipartMinPartSize, s3MultipartMinPartSize)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rInt := acctest.RandInt()
+	var obj s3.GetObjectOutput
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSS3BucketObjectDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccAWSS3BucketObjectConfig_multipart(rInt, tmpFile.Name(), s3MultipartMinPartSize),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketObjectExists("aws_s3_bucket_object.object", &obj),
+					resource.TestCheckResourceAttr("aws_s3_bucket_object.object", "etag", contentMD5),
+					resource.TestCheckResourceAttr("aws_s3_bucket_object.object", "multipart_etag", multipartEtag),
+				),
+			},
+			resource.TestStep{
+				// Raising the threshold must not upload the object again.
+				Config: testAccAWSS3BucketObjectConfig_multipart(rInt, tmpFile.Name(), 4*s3MultipartMinPartSize),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("aws_s3_bucket_object.object", "etag", contentMD5),
+					resource.TestCheckResourceAttr("aws_s3_bucket_object.object", "multipart_etag", multipartEtag),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAWSS3BucketObject_updatesWithVersioning(t *testing.T) {
 	tmpFile, err := ioutil.TempFile("", "tf-acc-s3-obj-updates-w-versions")
 	if err != nil {
@@ -202,6 +250,23 @@ func testAccCheckAWSS3BucketObjectVersionIdDiffers(first, second *s3.GetObjectOu
 	}
 }
 
+func testAccCheckAWSS3BucketObjectVersionIdEquals(first, second *s3.GetObjectOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if first.VersionId == nil {
+			return fmt.Errorf("Expected first object to have VersionId: %s", first)
+		}
+		if second.VersionId == nil {
+			return fmt.Errorf("Expected second object to have VersionId: %s", second)
+		}
+
+		if *first.VersionId != *second.VersionId {
+			return fmt.Errorf("Expected Version IDs to be equal, but they differ (%s, %s)", *first.VersionId, *second.VersionId)
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckAWSS3BucketObjectDestroy(s *terraform.State) error {
 	s3conn := testAccProvider.Meta().(*AWSClient).s3conn
 
@@ -536,7 +601,7 @@ func testAccCheckAWSS3BucketObjectSSE(n, expectedSSE string) resource.TestCheckF
 
 func TestAccAWSS3BucketObject_tags(t *testing.T) {
 	rInt := acctest.RandInt()
-	var obj s3.GetObjectOutput
+	var obj, updatedObj, untaggedObj s3.GetObjectOutput
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -551,10 +616,102 @@ func TestAccAWSS3BucketObject_tags(t *testing.T) {
 					resource.TestCheckResourceAttr("aws_s3_bucket_object.object", "tags.%", "2"),
 				),
 			},
+			resource.TestStep{
+				Config: testAccAWSS3BucketObjectConfig_withUpdatedTags(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketObjectExists("aws_s3_bucket_object.object", &updatedObj),
+					testAccCheckAWSS3BucketObjectVersionIdEquals(&obj, &updatedObj),
+					resource.TestCheckResourceAttr("aws_s3_bucket_object.object", "tags.%", "2"),
+					resource.TestCheckResourceAttr("aws_s3_bucket_object.object", "tags.Key1", "Value One Changed"),
+					resource.TestCheckResourceAttr("aws_s3_bucket_object.object", "tags.Key2", "Value Two"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccAWSS3BucketObjectConfig_withNoTags(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketObjectExists("aws_s3_bucket_object.object", &untaggedObj),
+					testAccCheckAWSS3BucketObjectVersionIdEquals(&obj, &untaggedObj),
+					resource.TestCheckResourceAttr("aws_s3_bucket_object.object", "tags.%", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSS3BucketObject_metadata(t *testing.T) {
+	rInt := acctest.RandInt()
+	var obj, updatedObj s3.GetObjectOutput
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSS3BucketObjectDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccAWSS3BucketObjectConfig_withMetadata(rInt, "key1", "value1", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketObjectExists("aws_s3_bucket_object.object", &obj),
+					resource.TestCheckResourceAttr("aws_s3_bucket_object.object", "metadata.%", "2"),
+					resource.TestCheckResourceAttr("aws_s3_bucket_object.object", "metadata.key1", "value1"),
+					resource.TestCheckResourceAttr("aws_s3_bucket_object.object", "metadata.key2", "value2"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccAWSS3BucketObjectConfig_withMetadata(rInt, "key1", "value1updated", "key3", "value3"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketObjectExists("aws_s3_bucket_object.object", &updatedObj),
+					testAccCheckAWSS3BucketObjectVersionIdDiffers(&obj, &updatedObj),
+					resource.TestCheckResourceAttr("aws_s3_bucket_object.object", "metadata.%", "2"),
+					resource.TestCheckResourceAttr("aws_s3_bucket_object.object", "metadata.key1", "value1updated"),
+					resource.TestCheckResourceAttr("aws_s3_bucket_object.object", "metadata.key3", "value3"),
+				),
+			},
 		},
 	})
 }
 
+func TestResourceAWSS3BucketObjectMetadata_validation(t *testing.T) {
+	var testCases = []struct {
+		Value    map[string]interface{}
+		ErrCount int
+	}{
+		{
+			Value:    map[string]interface{}{"key1": "Value1", "key-2": "VALUE2"},
+			ErrCount: 0,
+		},
+		{
+			Value:    map[string]interface{}{"Key1": "value1"},
+			ErrCount: 1,
+		},
+		{
+			Value:    map[string]interface{}{"Key1": "value1", "KEY2": "value2", "key3": "value3"},
+			ErrCount: 2,
+		},
+	}
+
+	for _, tc := range testCases {
+		_, errors := validateS3BucketObjectMetadata(tc.Value, "metadata")
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected %d validation errors for %v, got %d", tc.ErrCount, tc.Value, len(errors))
+		}
+	}
+}
+
+func TestFlattenS3ObjectMetadata(t *testing.T) {
+	metadata := map[string]*string{
+		"Key1":    aws.String("Value1"),
+		"Key-Two": aws.String("value2"),
+	}
+	expected := map[string]interface{}{
+		"key1":    "Value1",
+		"key-two": "value2",
+	}
+
+	if actual := flattenS3ObjectMetadata(metadata); !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("Expected %#v, got %#v", expected, actual)
+	}
+}
+
 func testAccAWSS3BucketObjectConfigSource(randInt int, source string) string {
 	return fmt.Sprintf(`
 resource "aws_s3_bucket" "object_bucket" {
@@ -614,6 +771,23 @@ resource "aws_s3_bucket_object" "object" {
 `, randInt, source, source)
 }
 
+func testAccAWSS3BucketObjectConfig_multipart(randInt int, source string, threshold int) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "object_bucket" {
+	bucket = "tf-object-test-bucket-%d"
+}
+
+resource "aws_s3_bucket_object" "object" {
+	bucket              = "${aws_s3_bucket.object_bucket.bucket}"
+	key                 = "multipart-key"
+	source              = "%s"
+	etag                = "${md5(file("%s"))}"
+	multipart_threshold = %d
+	multipart_part_size = %d
+}
+`, randInt, source, source, threshold, s3MultipartMinPartSize)
+}
+
 func testAccAWSS3BucketObjectConfig_updatesWithVersioning(randInt int, source string) string {
 	return fmt.Sprintf(`
 resource "aws_s3_bucket" "object_bucket_3" {
@@ -697,6 +871,9 @@ func testAccAWSS3BucketObjectConfig_withTags(randInt int) string {
 	return fmt.Sprintf(`
 resource "aws_s3_bucket" "object_bucket_2" {
 	bucket = "tf-object-test-bucket-%d"
+	versioning {
+		enabled = true
+	}
 }
 
 resource "aws_s3_bucket_object" "object" {
@@ -710,3 +887,62 @@ resource "aws_s3_bucket_object" "object" {
 }
 `, randInt)
 }
+
+func testAccAWSS3BucketObjectConfig_withUpdatedTags(randInt int) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "object_bucket_2" {
+	bucket = "tf-object-test-bucket-%d"
+	versioning {
+		enabled = true
+	}
+}
+
+resource "aws_s3_bucket_object" "object" {
+	bucket = "${aws_s3_bucket.object_bucket_2.bucket}"
+	key = "test-key"
+	content = "stuff"
+	tags {
+		Key1 = "Value One Changed"
+		Key2 = "Value Two"
+	}
+}
+`, randInt)
+}
+
+func testAccAWSS3BucketObjectConfig_withNoTags(randInt int) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "object_bucket_2" {
+	bucket = "tf-object-test-bucket-%d"
+	versioning {
+		enabled = true
+	}
+}
+
+resource "aws_s3_bucket_object" "object" {
+	bucket = "${aws_s3_bucket.object_bucket_2.bucket}"
+	key = "test-key"
+	content = "stuff"
+}
+`, randInt)
+}
+
+func testAccAWSS3BucketObjectConfig_withMetadata(randInt int, metadataKey1, metadataValue1, metadataKey2, metadataValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "object_bucket" {
+	bucket = "tf-object-test-bucket-%d"
+	versioning {
+		enabled = true
+	}
+}
+
+resource "aws_s3_bucket_object" "object" {
+	bucket = "${aws_s3_bucket.object_bucket.bucket}"
+	key = "test-key"
+	content = "stuff"
+	metadata {
+		%s = "%s"
+		%s = "%s"
+	}
+}
+`, randInt, metadataKey1, metadataValue1, metadataKey2, metadataValue2)
+}
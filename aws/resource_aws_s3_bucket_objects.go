@@ -0,0 +1,676 @@
+package aws
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/mitchellh/go-homedir"
+)
+
+func resourceAwsS3BucketObjects() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsS3BucketObjectsCreate,
+		Read:   resourceAwsS3BucketObjectsRead,
+		Update: resourceAwsS3BucketObjectsUpdate,
+		Delete: resourceAwsS3BucketObjectsDelete,
+
+		CustomizeDiff: resourceAwsS3BucketObjectsCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"source_dir": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"excludes": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+
+			"acl": {
+				Type:         schema.TypeString,
+				Default:      "private",
+				Optional:     true,
+				ValidateFunc: validateS3BucketObjectAclType,
+			},
+
+			"cache_control": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"pattern": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"value": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+
+			"content_types": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"delete_extraneous": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"parallelism": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      8,
+				ValidateFunc: validation.IntBetween(1, 64),
+			},
+
+			"manifest_hash": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"etags": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"remote_etags": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceAwsS3BucketObjectsCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	// An interpolated source_dir which isn't known yet reads as empty.
+	if d.Get("source_dir").(string) == "" {
+		return nil
+	}
+
+	// Scan source_dir at plan time so that changed, added and removed files
+	// show up as a change of etags.
+	manifest, err := buildS3ObjectsManifest(d)
+	if err != nil {
+		return err
+	}
+
+	if hash := manifest.hash(); hash != d.Get("manifest_hash").(string) {
+		if err := d.SetNew("manifest_hash", hash); err != nil {
+			return err
+		}
+	}
+
+	etags := manifest.etags()
+	if !reflect.DeepEqual(etags, d.Get("etags").(map[string]interface{})) {
+		if err := d.SetNew("etags", etags); err != nil {
+			return err
+		}
+		if err := d.SetNewComputed("remote_etags"); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func resourceAwsS3BucketObjectsCreate(d *schema.ResourceData, meta interface{}) error {
+	if err := resourceAwsS3BucketObjectsSync(d, meta, nil, nil, true); err != nil {
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", d.Get("bucket").(string), d.Get("prefix").(string)))
+
+	return resourceAwsS3BucketObjectsRead(d, meta)
+}
+
+func resourceAwsS3BucketObjectsUpdate(d *schema.ResourceData, meta interface{}) error {
+	// Changing how metadata is derived may affect any file, and S3 can only
+	// change an object's headers by writing it again.
+	uploadAll := d.HasChange("acl") || d.HasChange("cache_control") || d.HasChange("content_types")
+
+	previous, _ := d.GetChange("etags")
+	previousRemote, _ := d.GetChange("remote_etags")
+	if err := resourceAwsS3BucketObjectsSync(d, meta, previous.(map[string]interface{}), previousRemote.(map[string]interface{}), uploadAll); err != nil {
+		return err
+	}
+
+	return resourceAwsS3BucketObjectsRead(d, meta)
+}
+
+// resourceAwsS3BucketObjectsSync uploads every file in source_dir whose ETag
+// differs from the object under prefix (or every file, if uploadAll is set).
+// Objects which no longer have a counterpart in source_dir are deleted if
+// they were previously managed, or if delete_extraneous is set.
+func resourceAwsS3BucketObjectsSync(d *schema.ResourceData, meta interface{}, previous, previousRemote map[string]interface{}, uploadAll bool) error {
+	conn := meta.(*AWSClient).s3conn
+	bucket := d.Get("bucket").(string)
+	prefix := d.Get("prefix").(string)
+
+	manifest, err := buildS3ObjectsManifest(d)
+	if err != nil {
+		return err
+	}
+
+	remote, err := listS3ObjectEtags(conn, bucket, prefix)
+	if err != nil {
+		return fmt.Errorf("Error listing objects in s3://%s/%s: %s", bucket, prefix, err)
+	}
+
+	current := effectiveS3ObjectEtags(remote, previous, previousRemote)
+
+	var uploads []*s3ObjectsManifestEntry
+	for _, key := range manifest.keys() {
+		entry := manifest[key]
+		if uploadAll || current[key] != entry.Etag {
+			uploads = append(uploads, entry)
+		}
+	}
+
+	log.Printf("[DEBUG] Uploading %d of %d files from %s to s3://%s/%s", len(uploads), len(manifest), d.Get("source_dir").(string), bucket, prefix)
+	uploaded, err := uploadS3ObjectsManifestEntries(conn, bucket, d.Get("acl").(string), uploads, d.Get("parallelism").(int))
+
+	// Record what S3 reported for every object which is now in place, even if
+	// some uploads failed, so that they aren't uploaded again.
+	remoteEtags := make(map[string]interface{})
+	for key := range manifest {
+		if v, ok := uploaded[key]; ok {
+			remoteEtags[key] = v
+		} else if v, ok := remote[key]; ok && current[key] == manifest[key].Etag {
+			remoteEtags[key] = v
+		}
+	}
+	if err := d.Set("remote_etags", remoteEtags); err != nil {
+		return fmt.Errorf("Error setting remote_etags: %s", err)
+	}
+	if err != nil {
+		return err
+	}
+
+	deleteExtraneous := d.Get("delete_extraneous").(bool)
+	var stale []string
+	for key := range remote {
+		if _, ok := manifest[key]; ok {
+			continue
+		}
+		if _, ok := previous[key]; ok || deleteExtraneous {
+			stale = append(stale, key)
+		}
+	}
+	sort.Strings(stale)
+
+	log.Printf("[DEBUG] Deleting %d objects from s3://%s/%s", len(stale), bucket, prefix)
+	return deleteS3Objects(conn, bucket, stale)
+}
+
+func resourceAwsS3BucketObjectsRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).s3conn
+	bucket := d.Get("bucket").(string)
+	prefix := d.Get("prefix").(string)
+
+	remote, err := listS3ObjectEtags(conn, bucket, prefix)
+	if err != nil {
+		if isAWSErr(err, s3.ErrCodeNoSuchBucket, "") {
+			log.Printf("[WARN] S3 Bucket (%s) not found, removing objects %s from state", bucket, d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error listing objects in s3://%s/%s: %s", bucket, prefix, err)
+	}
+
+	// Only objects this resource uploaded are tracked, unless it owns the
+	// whole prefix.
+	deleteExtraneous := d.Get("delete_extraneous").(bool)
+	managed := d.Get("etags").(map[string]interface{})
+	uploaded := d.Get("remote_etags").(map[string]interface{})
+
+	etags := make(map[string]interface{})
+	remoteEtags := make(map[string]interface{})
+	for key, etag := range effectiveS3ObjectEtags(remote, managed, uploaded) {
+		if _, ok := managed[key]; ok || deleteExtraneous {
+			etags[key] = etag
+			if v, ok := uploaded[key]; ok {
+				remoteEtags[key] = v
+			}
+		}
+	}
+	if err := d.Set("etags", etags); err != nil {
+		return fmt.Errorf("Error setting etags: %s", err)
+	}
+	if err := d.Set("remote_etags", remoteEtags); err != nil {
+		return fmt.Errorf("Error setting remote_etags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsS3BucketObjectsDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).s3conn
+	bucket := d.Get("bucket").(string)
+
+	var keys []string
+	for key := range d.Get("etags").(map[string]interface{}) {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	log.Printf("[DEBUG] Deleting %d objects from s3://%s", len(keys), bucket)
+	if err := deleteS3Objects(conn, bucket, keys); err != nil {
+		if isAWSErr(err, s3.ErrCodeNoSuchBucket, "") {
+			return nil
+		}
+		return err
+	}
+
+	return nil
+}
+
+// effectiveS3ObjectEtags returns the ETag of every remote object, as it
+// compares to the ETag of a local file. The ETag S3 reports for an object
+// encrypted with SSE-KMS or SSE-C isn't derived from its content alone, so an
+// object whose ETag is still the one S3 reported when it was uploaded (as
+// recorded in uploaded) is reported by the local ETag it was uploaded with.
+func effectiveS3ObjectEtags(remote map[string]string, local, uploaded map[string]interface{}) map[string]string {
+	etags := make(map[string]string, len(remote))
+	for key, etag := range remote {
+		if v, ok := uploaded[key]; ok && v.(string) == etag {
+			if l, ok := local[key]; ok {
+				etag = l.(string)
+			}
+		}
+		etags[key] = etag
+	}
+	return etags
+}
+
+// s3ObjectsManifestEntry describes how a single file in source_dir is
+// stored in S3.
+type s3ObjectsManifestEntry struct {
+	Key          string
+	Path         string
+	Size         int64
+	Etag         string
+	ContentType  string
+	CacheControl string
+}
+
+// s3ObjectsManifest maps S3 keys to the files which should be stored there.
+type s3ObjectsManifest map[string]*s3ObjectsManifestEntry
+
+func (m s3ObjectsManifest) keys() []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func (m s3ObjectsManifest) etags() map[string]interface{} {
+	etags := make(map[string]interface{}, len(m))
+	for key, entry := range m {
+		etags[key] = entry.Etag
+	}
+	return etags
+}
+
+// hash summarises everything which ends up in S3, including the headers
+// which aren't reflected in the ETags.
+func (m s3ObjectsManifest) hash() string {
+	h := sha256.New()
+	for _, key := range m.keys() {
+		entry := m[key]
+		fmt.Fprintf(h, "%s\x00%s\x00%s\x00%s\n", key, entry.Etag, entry.ContentType, entry.CacheControl)
+	}
+	return fmt.Sprintf("%x", h.Sum(nil))
+}
+
+type s3ObjectsCacheControlRule struct {
+	Pattern string
+	Value   string
+}
+
+// buildS3ObjectsManifest scans source_dir, hashing every file which isn't
+// excluded. It accepts both *schema.ResourceData and *schema.ResourceDiff.
+func buildS3ObjectsManifest(d interface {
+	Get(string) interface{}
+}) (s3ObjectsManifest, error) {
+	sourceDir := d.Get("source_dir").(string)
+	prefix := d.Get("prefix").(string)
+	excludes := aws.StringValueSlice(expandStringSet(d.Get("excludes").(*schema.Set)))
+	contentTypes := d.Get("content_types").(map[string]interface{})
+
+	var cacheControl []s3ObjectsCacheControlRule
+	for _, v := range d.Get("cache_control").([]interface{}) {
+		rule := v.(map[string]interface{})
+		cacheControl = append(cacheControl, s3ObjectsCacheControlRule{
+			Pattern: rule["pattern"].(string),
+			Value:   rule["value"].(string),
+		})
+	}
+
+	for _, pattern := range excludes {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return nil, fmt.Errorf("invalid exclude pattern %q: %s", pattern, err)
+		}
+	}
+	for _, rule := range cacheControl {
+		if _, err := path.Match(rule.Pattern, ""); err != nil {
+			return nil, fmt.Errorf("invalid cache_control pattern %q: %s", rule.Pattern, err)
+		}
+	}
+
+	root, err := homedir.Expand(sourceDir)
+	if err != nil {
+		return nil, fmt.Errorf("Error expanding homedir in source_dir (%s): %s", sourceDir, err)
+	}
+	info, err := os.Stat(root)
+	if err != nil {
+		return nil, fmt.Errorf("Error reading source_dir (%s): %s", sourceDir, err)
+	}
+	if !info.IsDir() {
+		return nil, fmt.Errorf("source_dir (%s) is not a directory", sourceDir)
+	}
+
+	manifest := make(s3ObjectsManifest)
+	err = filepath.Walk(root, func(p string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if p == root {
+			return nil
+		}
+
+		rel, err := filepath.Rel(root, p)
+		if err != nil {
+			return err
+		}
+		rel = filepath.ToSlash(rel)
+
+		for _, pattern := range excludes {
+			if s3ObjectsPatternMatch(pattern, rel) {
+				if info.IsDir() {
+					return filepath.SkipDir
+				}
+				return nil
+			}
+		}
+
+		// Stat follows symlinks, so linked files are uploaded by content.
+		info, err = os.Stat(p)
+		if err != nil {
+			return err
+		}
+		if !info.Mode().IsRegular() {
+			return nil
+		}
+
+		entry := &s3ObjectsManifestEntry{
+			Key:  prefix + rel,
+			Path: p,
+			Size: info.Size(),
+		}
+
+		for _, rule := range cacheControl {
+			if s3ObjectsPatternMatch(rule.Pattern, rel) {
+				entry.CacheControl = rule.Value
+				break
+			}
+		}
+
+		if err := entry.scan(contentTypes); err != nil {
+			return err
+		}
+
+		manifest[entry.Key] = entry
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Error reading source_dir (%s): %s", sourceDir, err)
+	}
+
+	return manifest, nil
+}
+
+// scan reads the file once to compute its ETag and, unless the extension is
+// mapped in contentTypes or s3ObjectsContentTypes, sniff its content type.
+func (e *s3ObjectsManifestEntry) scan(contentTypes map[string]interface{}) error {
+	f, err := os.Open(e.Path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	head := make([]byte, 512)
+	n, err := io.ReadFull(f, head)
+	if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+		return err
+	}
+	head = head[:n]
+
+	ext := strings.ToLower(path.Ext(e.Path))
+	if v, ok := contentTypes[ext]; ok {
+		e.ContentType = v.(string)
+	} else if v, ok := s3ObjectsContentTypes[ext]; ok {
+		e.ContentType = v
+	} else {
+		e.ContentType = http.DetectContentType(head)
+	}
+
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+	e.Etag, err = s3ObjectEtag(f, e.Size, s3MultipartDefaultPartSize, s3MultipartDefaultPartSize)
+	return err
+}
+
+// s3ObjectsPatternMatch matches a glob against a slash separated path
+// relative to source_dir. Patterns without a slash match the base name of a
+// file or directory at any depth.
+func s3ObjectsPatternMatch(pattern, rel string) bool {
+	name := rel
+	if !strings.Contains(pattern, "/") {
+		name = path.Base(rel)
+	}
+	matched, _ := path.Match(pattern, name)
+	return matched
+}
+
+// s3ObjectsContentTypes maps common file extensions to content types. It is
+// used instead of the mime package, whose results depend on the mime.types
+// files installed on the machine running Terraform.
+var s3ObjectsContentTypes = map[string]string{
+	".css":   "text/css; charset=utf-8",
+	".csv":   "text/csv; charset=utf-8",
+	".eot":   "application/vnd.ms-fontobject",
+	".gif":   "image/gif",
+	".gz":    "application/gzip",
+	".htm":   "text/html; charset=utf-8",
+	".html":  "text/html; charset=utf-8",
+	".ico":   "image/x-icon",
+	".jpeg":  "image/jpeg",
+	".jpg":   "image/jpeg",
+	".js":    "application/javascript",
+	".json":  "application/json",
+	".map":   "application/json",
+	".md":    "text/markdown; charset=utf-8",
+	".mp3":   "audio/mpeg",
+	".mp4":   "video/mp4",
+	".otf":   "font/otf",
+	".pdf":   "application/pdf",
+	".png":   "image/png",
+	".svg":   "image/svg+xml",
+	".tar":   "application/x-tar",
+	".ttf":   "font/ttf",
+	".txt":   "text/plain; charset=utf-8",
+	".wasm":  "application/wasm",
+	".webm":  "video/webm",
+	".webp":  "image/webp",
+	".woff":  "font/woff",
+	".woff2": "font/woff2",
+	".xml":   "application/xml",
+	".zip":   "application/zip",
+}
+
+// uploadS3ObjectsManifestEntries uploads entries, returning the ETags S3
+// reported for the objects which were uploaded successfully.
+func uploadS3ObjectsManifestEntries(conn *s3.S3, bucket, acl string, entries []*s3ObjectsManifestEntry, parallelism int) (map[string]string, error) {
+	work := make(chan *s3ObjectsManifestEntry)
+	errs := make(chan error, len(entries))
+
+	var mu sync.Mutex
+	etags := make(map[string]string, len(entries))
+
+	var wg sync.WaitGroup
+	for i := 0; i < parallelism; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for entry := range work {
+				etag, err := uploadS3ObjectsManifestEntry(conn, bucket, acl, entry)
+				if err != nil {
+					errs <- fmt.Errorf("Error uploading %s to s3://%s/%s: %s", entry.Path, bucket, entry.Key, err)
+					continue
+				}
+				mu.Lock()
+				etags[entry.Key] = etag
+				mu.Unlock()
+			}
+		}()
+	}
+
+	for _, entry := range entries {
+		work <- entry
+	}
+	close(work)
+	wg.Wait()
+	close(errs)
+
+	var result *multierror.Error
+	for err := range errs {
+		result = multierror.Append(result, err)
+	}
+	return etags, result.ErrorOrNil()
+}
+
+func uploadS3ObjectsManifestEntry(conn *s3.S3, bucket, acl string, entry *s3ObjectsManifestEntry) (string, error) {
+	f, err := os.Open(entry.Path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	input := &s3.PutObjectInput{
+		ACL:         aws.String(acl),
+		Bucket:      aws.String(bucket),
+		ContentType: aws.String(entry.ContentType),
+		Key:         aws.String(entry.Key),
+	}
+	if entry.CacheControl != "" {
+		input.CacheControl = aws.String(entry.CacheControl)
+	}
+
+	out, err := putS3Object(conn, input, f, entry.Size, s3MultipartDefaultPartSize, s3MultipartDefaultPartSize)
+	if err != nil {
+		return "", err
+	}
+	return strings.Trim(aws.StringValue(out.ETag), `"`), nil
+}
+
+// listS3ObjectEtags returns the ETag of every object under prefix.
+func listS3ObjectEtags(conn *s3.S3, bucket, prefix string) (map[string]string, error) {
+	etags := make(map[string]string)
+
+	input := &s3.ListObjectsV2Input{
+		Bucket: aws.String(bucket),
+	}
+	if prefix != "" {
+		input.Prefix = aws.String(prefix)
+	}
+
+	err := conn.ListObjectsV2Pages(input, func(page *s3.ListObjectsV2Output, lastPage bool) bool {
+		for _, object := range page.Contents {
+			// See https://forums.aws.amazon.com/thread.jspa?threadID=44003
+			etags[aws.StringValue(object.Key)] = strings.Trim(aws.StringValue(object.ETag), `"`)
+		}
+		return !lastPage
+	})
+
+	return etags, err
+}
+
+// deleteS3Objects deletes keys in batches of the 1000 keys DeleteObjects
+// accepts at a time.
+func deleteS3Objects(conn *s3.S3, bucket string, keys []string) error {
+	for len(keys) > 0 {
+		n := len(keys)
+		if n > 1000 {
+			n = 1000
+		}
+
+		objects := make([]*s3.ObjectIdentifier, 0, n)
+		for _, key := range keys[:n] {
+			objects = append(objects, &s3.ObjectIdentifier{Key: aws.String(key)})
+		}
+		keys = keys[n:]
+
+		out, err := conn.DeleteObjects(&s3.DeleteObjectsInput{
+			Bucket: aws.String(bucket),
+			Delete: &s3.Delete{
+				Objects: objects,
+				Quiet:   aws.Bool(true),
+			},
+		})
+		if err != nil {
+			return err
+		}
+
+		var result *multierror.Error
+		for _, e := range out.Errors {
+			result = multierror.Append(result, fmt.Errorf("Error deleting s3://%s/%s: %s: %s",
+				bucket, aws.StringValue(e.Key), aws.StringValue(e.Code), aws.StringValue(e.Message)))
+		}
+		if err := result.ErrorOrNil(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,342 @@
+package aws
+
+import (
+	"crypto/md5"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestS3ObjectsPatternMatch(t *testing.T) {
+	testCases := []struct {
+		Pattern  string
+		Path     string
+		Expected bool
+	}{
+		{"*.html", "index.html", true},
+		{"*.html", "docs/index.html", true},
+		{"*.html", "index.htm", false},
+		{"assets/*", "assets/app.js", true},
+		{"assets/*", "assets/img/logo.png", false},
+		{"assets/*", "docs/assets/app.js", false},
+		{".git", ".git", true},
+		{".git", "sub/.git", true},
+	}
+
+	for _, tc := range testCases {
+		if actual := s3ObjectsPatternMatch(tc.Pattern, tc.Path); actual != tc.Expected {
+			t.Errorf("%q matching %q: expected %t, got %t", tc.Pattern, tc.Path, tc.Expected, actual)
+		}
+	}
+}
+
+func TestEffectiveS3ObjectEtags(t *testing.T) {
+	remote := map[string]string{
+		"plain":     "d41d8cd98f00b204e9800998ecf8427e",
+		"kms":       "0123456789abcdef0123456789abcdef",
+		"kms-moved": "fedcba9876543210fedcba9876543210",
+		"unmanaged": "00000000000000000000000000000000",
+	}
+	local := map[string]interface{}{
+		"plain":     "d41d8cd98f00b204e9800998ecf8427e",
+		"kms":       "5d41402abc4b2a76b9719d911017c592",
+		"kms-moved": "7d793037a0760186574b0282f2f435e7",
+	}
+	uploaded := map[string]interface{}{
+		"plain":     "d41d8cd98f00b204e9800998ecf8427e",
+		"kms":       "0123456789abcdef0123456789abcdef",
+		"kms-moved": "11111111111111111111111111111111",
+	}
+
+	expected := map[string]string{
+		"plain": "d41d8cd98f00b204e9800998ecf8427e",
+		// Unchanged since it was uploaded, so it's compared by its local ETag.
+		"kms": "5d41402abc4b2a76b9719d911017c592",
+		// Changed outside of Terraform.
+		"kms-moved": "fedcba9876543210fedcba9876543210",
+		"unmanaged": "00000000000000000000000000000000",
+	}
+
+	actual := effectiveS3ObjectEtags(remote, local, uploaded)
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("expected %#v, got %#v", expected, actual)
+	}
+}
+
+func TestBuildS3ObjectsManifest(t *testing.T) {
+	dir, err := ioutil.TempDir("", "tf-s3-objects")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	files := map[string]string{
+		"index.html":         "<html></html>",
+		"assets/app.js":      "console.log('hi');",
+		"assets/data.custom": "{}",
+		"assets/noext":       "plain text",
+		".git/config":        "[core]",
+	}
+	for name, content := range files {
+		p := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(p), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(p, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	d := schema.TestResourceDataRaw(t, resourceAwsS3BucketObjects().Schema, map[string]interface{}{
+		"bucket":     "test",
+		"prefix":     "site/",
+		"source_dir": dir,
+		"excludes":   []interface{}{".git"},
+		"cache_control": []interface{}{
+			map[string]interface{}{
+				"pattern": "*.html",
+				"value":   "no-cache",
+			},
+			map[string]interface{}{
+				"pattern": "assets/*",
+				"value":   "max-age=31536000",
+			},
+		},
+		"content_types": map[string]interface{}{
+			".custom": "application/x-custom",
+		},
+	})
+
+	manifest, err := buildS3ObjectsManifest(d)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := map[string]struct {
+		Etag         string
+		ContentType  string
+		CacheControl string
+	}{
+		"site/index.html": {
+			Etag:         fmt.Sprintf("%x", md5.Sum([]byte("<html></html>"))),
+			ContentType:  "text/html; charset=utf-8",
+			CacheControl: "no-cache",
+		},
+		"site/assets/app.js": {
+			ContentType:  "application/javascript",
+			CacheControl: "max-age=31536000",
+		},
+		"site/assets/data.custom": {
+			ContentType:  "application/x-custom",
+			CacheControl: "max-age=31536000",
+		},
+		"site/assets/noext": {
+			ContentType:  "text/plain; charset=utf-8",
+			CacheControl: "max-age=31536000",
+		},
+	}
+
+	if len(manifest) != len(expected) {
+		t.Fatalf("expected keys %v, got %v", len(expected), manifest.keys())
+	}
+	for key, e := range expected {
+		entry, ok := manifest[key]
+		if !ok {
+			t.Fatalf("expected key %q in manifest, got %v", key, manifest.keys())
+		}
+		if e.Etag != "" && entry.Etag != e.Etag {
+			t.Errorf("%s: expected etag %q, got %q", key, e.Etag, entry.Etag)
+		}
+		if entry.ContentType != e.ContentType {
+			t.Errorf("%s: expected content type %q, got %q", key, e.ContentType, entry.ContentType)
+		}
+		if entry.CacheControl != e.CacheControl {
+			t.Errorf("%s: expected cache control %q, got %q", key, e.CacheControl, entry.CacheControl)
+		}
+	}
+
+	// The hash only depends on what is stored, so it must be stable.
+	again, err := buildS3ObjectsManifest(d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if manifest.hash() != again.hash() {
+		t.Fatalf("expected a stable manifest hash")
+	}
+}
+
+func TestAccAWSS3BucketObjects_basic(t *testing.T) {
+	dir, err := ioutil.TempDir("", "tf-acc-s3-objects")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	writeFile := func(name, content string) {
+		p := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(p), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(p, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	writeFile("index.html", "<html>initial</html>")
+	writeFile("css/site.css", "body {}")
+
+	rInt := acctest.RandInt()
+	resourceName := "aws_s3_bucket_objects.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSS3BucketObjectsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSS3BucketObjectsConfig(rInt, dir, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "etags.%", "2"),
+					resource.TestCheckResourceAttrSet(resourceName, "manifest_hash"),
+					testAccCheckAWSS3BucketObjectsObject(resourceName, "site/index.html", "text/html; charset=utf-8", "no-cache"),
+					testAccCheckAWSS3BucketObjectsObject(resourceName, "site/css/site.css", "text/css; charset=utf-8", ""),
+				),
+			},
+			{
+				PreConfig: func() {
+					writeFile("index.html", "<html>modified</html>")
+					writeFile("js/app.js", "console.log('hi');")
+					if err := os.Remove(filepath.Join(dir, "css", "site.css")); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccAWSS3BucketObjectsConfig(rInt, dir, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "etags.%", "2"),
+					resource.TestCheckResourceAttrSet(resourceName, "etags.site/js/app.js"),
+					testAccCheckAWSS3BucketObjectsObject(resourceName, "site/js/app.js", "application/javascript", ""),
+					testAccCheckAWSS3BucketObjectsNoObject(resourceName, "site/css/site.css"),
+				),
+			},
+			{
+				PreConfig: func() {
+					// An object under the prefix which Terraform doesn't know about.
+					conn := testAccProvider.Meta().(*AWSClient).s3conn
+					_, err := conn.PutObject(&s3.PutObjectInput{
+						Bucket: aws.String(fmt.Sprintf("tf-object-test-bucket-%d", rInt)),
+						Key:    aws.String("site/extraneous.txt"),
+						Body:   strings.NewReader("not part of the site"),
+					})
+					if err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccAWSS3BucketObjectsConfig(rInt, dir, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "etags.%", "2"),
+					testAccCheckAWSS3BucketObjectsNoObject(resourceName, "site/extraneous.txt"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSS3BucketObjectsObject(n, key, contentType, cacheControl string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).s3conn
+		out, err := conn.HeadObject(&s3.HeadObjectInput{
+			Bucket: aws.String(rs.Primary.Attributes["bucket"]),
+			Key:    aws.String(key),
+		})
+		if err != nil {
+			return fmt.Errorf("Error reading %s: %s", key, err)
+		}
+
+		if v := aws.StringValue(out.ContentType); v != contentType {
+			return fmt.Errorf("%s: expected content type %q, got %q", key, contentType, v)
+		}
+		if v := aws.StringValue(out.CacheControl); v != cacheControl {
+			return fmt.Errorf("%s: expected cache control %q, got %q", key, cacheControl, v)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAWSS3BucketObjectsNoObject(n, key string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).s3conn
+		etags, err := listS3ObjectEtags(conn, rs.Primary.Attributes["bucket"], key)
+		if err != nil {
+			return err
+		}
+		if _, ok := etags[key]; ok {
+			return fmt.Errorf("Expected %s to have been deleted", key)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAWSS3BucketObjectsDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).s3conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_s3_bucket_objects" {
+			continue
+		}
+
+		etags, err := listS3ObjectEtags(conn, rs.Primary.Attributes["bucket"], rs.Primary.Attributes["prefix"])
+		if err != nil {
+			if isAWSErr(err, s3.ErrCodeNoSuchBucket, "") {
+				continue
+			}
+			return err
+		}
+		if len(etags) > 0 {
+			return fmt.Errorf("S3 objects still exist under %s", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccAWSS3BucketObjectsConfig(randInt int, dir string, deleteExtraneous bool) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = "tf-object-test-bucket-%d"
+}
+
+resource "aws_s3_bucket_objects" "test" {
+  bucket     = "${aws_s3_bucket.test.bucket}"
+  prefix     = "site/"
+  source_dir = "%s"
+
+  cache_control {
+    pattern = "*.html"
+    value   = "no-cache"
+  }
+
+  delete_extraneous = %t
+}
+`, randInt, dir, deleteExtraneous)
+}
@@ -0,0 +1,85 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func TestAccAWSS3BucketReplicationConfiguration_basic(t *testing.T) {
+	rInt := acctest.RandInt()
+	resourceName := "aws_s3_bucket_replication_configuration.test"
+
+	// record the initialized providers so that we can use them to check for the buckets in each region
+	var providers []*schema.Provider
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories(&providers),
+		CheckDestroy:      testAccCheckWithProviders(testAccCheckAWSS3BucketDestroyWithProvider, &providers),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSS3BucketReplicationConfigurationConfig(rInt, "STANDARD"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "bucket", testAccBucketName(rInt)),
+					resource.TestMatchResourceAttr(resourceName, "role", regexp.MustCompile(fmt.Sprintf("^arn:aws:iam::[\\d+]+:role/tf-iam-role-replication-%d", rInt))),
+					resource.TestCheckResourceAttr(resourceName, "rules.#", "1"),
+				),
+			},
+			{
+				Config: testAccAWSS3BucketReplicationConfigurationConfig(rInt, "STANDARD_IA"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "rules.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAWSS3BucketReplicationConfigurationConfig(randInt int, storageClass string) string {
+	return fmt.Sprintf(testAccAWSS3BucketConfigReplicationBasic+`
+resource "aws_s3_bucket" "bucket" {
+  provider = "aws.uswest2"
+  bucket   = "tf-test-bucket-%d"
+
+  versioning {
+    enabled = true
+  }
+
+  lifecycle {
+    ignore_changes = ["replication_configuration"]
+  }
+}
+
+resource "aws_s3_bucket" "destination" {
+  provider = "aws.euwest"
+  bucket   = "tf-test-bucket-destination-%d"
+  region   = "eu-west-1"
+
+  versioning {
+    enabled = true
+  }
+}
+
+resource "aws_s3_bucket_replication_configuration" "test" {
+  provider = "aws.uswest2"
+  bucket   = "${aws_s3_bucket.bucket.id}"
+  role     = "${aws_iam_role.role.arn}"
+
+  rules {
+    id     = "foobar"
+    prefix = "foo"
+    status = "Enabled"
+
+    destination {
+      bucket        = "${aws_s3_bucket.destination.arn}"
+      storage_class = "%s"
+    }
+  }
+}
+`, randInt, randInt, randInt, storageClass)
+}
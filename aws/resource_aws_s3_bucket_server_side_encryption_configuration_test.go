@@ -0,0 +1,117 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSS3BucketServerSideEncryptionConfiguration_basic(t *testing.T) {
+	rInt := acctest.RandInt()
+	resourceName := "aws_s3_bucket_server_side_encryption_configuration.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSS3BucketServerSideEncryptionConfigurationDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccAWSS3BucketServerSideEncryptionConfigurationConfig_AES256(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "bucket", testAccBucketName(rInt)),
+					resource.TestCheckResourceAttr(resourceName, "rule.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.apply_server_side_encryption_by_default.0.sse_algorithm", "AES256"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccAWSS3BucketServerSideEncryptionConfigurationConfig_KMS(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "rule.0.apply_server_side_encryption_by_default.0.sse_algorithm", "aws:kms"),
+					resource.TestMatchResourceAttr(resourceName, "rule.0.apply_server_side_encryption_by_default.0.kms_master_key_id", regexp.MustCompile("^arn")),
+				),
+			},
+			resource.TestStep{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSS3BucketServerSideEncryptionConfigurationDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).s3conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_s3_bucket_server_side_encryption_configuration" {
+			continue
+		}
+
+		_, err := conn.GetBucketEncryption(&s3.GetBucketEncryptionInput{
+			Bucket: aws.String(rs.Primary.ID),
+		})
+		if err == nil {
+			return fmt.Errorf("S3 bucket server side encryption configuration still exists: %s", rs.Primary.ID)
+		}
+		if !isAWSErr(err, s3.ErrCodeNoSuchBucket, "") && !isAWSErr(err, "ServerSideEncryptionConfigurationNotFoundError", "") {
+			return err
+		}
+	}
+	return nil
+}
+
+func testAccAWSS3BucketServerSideEncryptionConfigurationConfig_AES256(randInt int) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = "tf-test-bucket-%d"
+
+  lifecycle {
+    ignore_changes = ["server_side_encryption_configuration"]
+  }
+}
+
+resource "aws_s3_bucket_server_side_encryption_configuration" "test" {
+  bucket = "${aws_s3_bucket.test.id}"
+
+  rule {
+    apply_server_side_encryption_by_default {
+      sse_algorithm = "AES256"
+    }
+  }
+}
+`, randInt)
+}
+
+func testAccAWSS3BucketServerSideEncryptionConfigurationConfig_KMS(randInt int) string {
+	return fmt.Sprintf(`
+resource "aws_kms_key" "test" {
+  description             = "KMS key for tf-test-bucket-%d"
+  deletion_window_in_days = 7
+}
+
+resource "aws_s3_bucket" "test" {
+  bucket = "tf-test-bucket-%d"
+
+  lifecycle {
+    ignore_changes = ["server_side_encryption_configuration"]
+  }
+}
+
+resource "aws_s3_bucket_server_side_encryption_configuration" "test" {
+  bucket = "${aws_s3_bucket.test.id}"
+
+  rule {
+    apply_server_side_encryption_by_default {
+      kms_master_key_id = "${aws_kms_key.test.arn}"
+      sse_algorithm     = "aws:kms"
+    }
+  }
+}
+`, randInt, randInt)
+}
@@ -693,6 +693,14 @@ func TestAccAWSS3Bucket_Lifecycle(t *testing.T) {
 						"aws_s3_bucket.bucket", "lifecycle_rule.3.tags.tagKey", "tagValue"),
 					resource.TestCheckResourceAttr(
 						"aws_s3_bucket.bucket", "lifecycle_rule.3.tags.terraform", "hashicorp"),
+					resource.TestCheckResourceAttr(
+						"aws_s3_bucket.bucket", "lifecycle_rule.4.id", "id5"),
+					resource.TestCheckResourceAttr(
+						"aws_s3_bucket.bucket", "lifecycle_rule.4.prefix", ""),
+					resource.TestCheckResourceAttr(
+						"aws_s3_bucket.bucket", "lifecycle_rule.4.tags.%", "1"),
+					resource.TestCheckResourceAttr(
+						"aws_s3_bucket.bucket", "lifecycle_rule.4.tags.retention", "short"),
 				),
 			},
 			{
@@ -1736,6 +1744,18 @@ resource "aws_s3_bucket" "bucket" {
 			date = "2016-01-12"
 		}
 	}
+	lifecycle_rule {
+		id = "id5"
+		enabled = true
+
+		tags {
+			"retention" = "short"
+		}
+
+		expiration {
+			days = 30
+		}
+	}
 }
 `, randInt)
 }
@@ -0,0 +1,130 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsS3BucketVersioning() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsS3BucketVersioningPut,
+		Read:   resourceAwsS3BucketVersioningRead,
+		Update: resourceAwsS3BucketVersioningPut,
+		Delete: resourceAwsS3BucketVersioningDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Required: true,
+			},
+			"mfa_delete": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+		},
+	}
+}
+
+func resourceAwsS3BucketVersioningPut(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).s3conn
+	bucket := d.Get("bucket").(string)
+
+	input := &s3.PutBucketVersioningInput{
+		Bucket: aws.String(bucket),
+		VersioningConfiguration: expandS3BucketVersioning([]interface{}{
+			map[string]interface{}{
+				"enabled":    d.Get("enabled"),
+				"mfa_delete": d.Get("mfa_delete"),
+			},
+		}),
+	}
+
+	log.Printf("[DEBUG] Putting S3 bucket versioning: %s", input)
+	err := resource.Retry(1*time.Minute, func() *resource.RetryError {
+		_, err := conn.PutBucketVersioning(input)
+		if err != nil {
+			if isAWSErr(err, s3.ErrCodeNoSuchBucket, "") {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("Error putting S3 bucket versioning: %s", err)
+	}
+
+	d.SetId(bucket)
+
+	return resourceAwsS3BucketVersioningRead(d, meta)
+}
+
+func resourceAwsS3BucketVersioningRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).s3conn
+
+	input := &s3.GetBucketVersioningInput{
+		Bucket: aws.String(d.Id()),
+	}
+
+	log.Printf("[DEBUG] Reading S3 bucket versioning: %s", input)
+	output, err := conn.GetBucketVersioning(input)
+	if err != nil {
+		if isAWSErr(err, s3.ErrCodeNoSuchBucket, "") {
+			log.Printf("[WARN] %s S3 bucket not found, removing versioning from state.", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading S3 bucket versioning: %s", err)
+	}
+
+	// A bucket which never had versioning enabled has no status at all.
+	if output.Status == nil {
+		log.Printf("[WARN] %s S3 bucket versioning not found, removing from state.", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	vc := flattenS3BucketVersioning(output)
+	d.Set("bucket", d.Id())
+	d.Set("enabled", vc["enabled"])
+	d.Set("mfa_delete", vc["mfa_delete"])
+
+	return nil
+}
+
+// Versioning can't be turned off once it has been enabled, so deleting the
+// resource suspends it.
+func resourceAwsS3BucketVersioningDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).s3conn
+
+	input := &s3.PutBucketVersioningInput{
+		Bucket:                  aws.String(d.Id()),
+		VersioningConfiguration: expandS3BucketVersioning(nil),
+	}
+
+	log.Printf("[DEBUG] Suspending S3 bucket versioning: %s", input)
+	_, err := conn.PutBucketVersioning(input)
+	if err != nil {
+		if isAWSErr(err, s3.ErrCodeNoSuchBucket, "") {
+			return nil
+		}
+		return fmt.Errorf("Error suspending S3 bucket versioning: %s", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,101 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSS3BucketWebsiteConfiguration_basic(t *testing.T) {
+	rInt := acctest.RandInt()
+	resourceName := "aws_s3_bucket_website_configuration.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSS3BucketWebsiteConfigurationDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccAWSS3BucketWebsiteConfigurationConfig(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "index_document", "index.html"),
+					resource.TestCheckResourceAttr(resourceName, "error_document", "error.html"),
+					resource.TestCheckResourceAttr(resourceName, "website_endpoint", testAccWebsiteEndpoint(rInt)),
+				),
+			},
+			resource.TestStep{
+				Config: testAccAWSS3BucketWebsiteConfigurationConfig_redirect(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "index_document", ""),
+					resource.TestCheckResourceAttr(resourceName, "redirect_all_requests_to", "https://hashicorp.com"),
+				),
+			},
+			resource.TestStep{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSS3BucketWebsiteConfigurationDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).s3conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_s3_bucket_website_configuration" {
+			continue
+		}
+
+		_, err := conn.GetBucketWebsite(&s3.GetBucketWebsiteInput{
+			Bucket: aws.String(rs.Primary.ID),
+		})
+		if err == nil {
+			return fmt.Errorf("S3 bucket website configuration still exists: %s", rs.Primary.ID)
+		}
+		if !isAWSErr(err, s3.ErrCodeNoSuchBucket, "") && !isAWSErr(err, "NoSuchWebsiteConfiguration", "") {
+			return err
+		}
+	}
+	return nil
+}
+
+func testAccAWSS3BucketWebsiteConfigurationConfig(randInt int) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = "tf-test-bucket-%d"
+
+  lifecycle {
+    ignore_changes = ["website"]
+  }
+}
+
+resource "aws_s3_bucket_website_configuration" "test" {
+  bucket         = "${aws_s3_bucket.test.id}"
+  index_document = "index.html"
+  error_document = "error.html"
+}
+`, randInt)
+}
+
+func testAccAWSS3BucketWebsiteConfigurationConfig_redirect(randInt int) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = "tf-test-bucket-%d"
+
+  lifecycle {
+    ignore_changes = ["website"]
+  }
+}
+
+resource "aws_s3_bucket_website_configuration" "test" {
+  bucket                   = "${aws_s3_bucket.test.id}"
+  redirect_all_requests_to = "https://hashicorp.com"
+}
+`, randInt)
+}
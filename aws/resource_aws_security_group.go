@@ -15,6 +15,16 @@ import (
 	"github.com/hashicorp/terraform/helper/hashcode"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+const (
+	// The group's ingress and egress blocks are authoritative; any rule not
+	// declared inline is revoked.
+	securityGroupRuleManagementInline = "inline"
+	// Rules are managed with aws_security_group_rule and the group never
+	// authorizes or revokes them itself.
+	securityGroupRuleManagementExternal = "external"
 )
 
 func resourceAwsSecurityGroup() *schema.Resource {
@@ -27,6 +37,8 @@ func resourceAwsSecurityGroup() *schema.Resource {
 			State: resourceAwsSecurityGroupImportState,
 		},
 
+		CustomizeDiff: resourceAwsSecurityGroupCustomizeDiff,
+
 		SchemaVersion: 1,
 		MigrateState:  resourceAwsSecurityGroupMigrateState,
 
@@ -227,10 +239,47 @@ func resourceAwsSecurityGroup() *schema.Resource {
 				Default:  false,
 				Optional: true,
 			},
+
+			"rule_management": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  securityGroupRuleManagementInline,
+				ValidateFunc: validation.StringInSlice([]string{
+					securityGroupRuleManagementInline,
+					securityGroupRuleManagementExternal,
+				}, false),
+			},
 		},
 	}
 }
 
+func resourceAwsSecurityGroupCustomizeDiff(diff *schema.ResourceDiff, v interface{}) error {
+	if diff.Get("rule_management").(string) != securityGroupRuleManagementExternal {
+		return nil
+	}
+
+	// With external rule management the ingress and egress attributes are kept
+	// empty in state, so any rule declared inline shows up as a change here and
+	// would be managed twice.
+	for _, ruleset := range []string{"ingress", "egress"} {
+		if rules := diff.Get(ruleset).(*schema.Set); diff.HasChange(ruleset) && rules.Len() > 0 {
+			return fmt.Errorf("%q rules cannot be declared inline when rule_management is %q, use aws_security_group_rule instead",
+				ruleset, securityGroupRuleManagementExternal)
+		}
+	}
+
+	return nil
+}
+
+// isSecurityGroupRuleManagementExternal returns true if the security group's
+// rules are managed outside of its inline ingress and egress blocks.
+func isSecurityGroupRuleManagementExternal(d *schema.ResourceData) bool {
+	// aws_default_security_group shares this schema but always manages its
+	// rules explicitly, so the attribute may not exist.
+	v, ok := d.GetOk("rule_management")
+	return ok && v.(string) == securityGroupRuleManagementExternal
+}
+
 func resourceAwsSecurityGroupCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).ec2conn
 
@@ -374,6 +423,14 @@ func resourceAwsSecurityGroupRead(d *schema.ResourceData, meta interface{}) erro
 	ingressRules := matchRules("ingress", localIngressRules, remoteIngressRules)
 	egressRules := matchRules("egress", localEgressRules, remoteEgressRules)
 
+	// Externally managed rules are owned by aws_security_group_rule resources,
+	// so they're kept out of state. Any rule declared inline then shows up as a
+	// change, which CustomizeDiff reports as being managed twice.
+	if isSecurityGroupRuleManagementExternal(d) {
+		ingressRules = nil
+		egressRules = nil
+	}
+
 	d.Set("description", sg.Description)
 	d.Set("name", sg.GroupName)
 	d.Set("vpc_id", sg.VpcId)
@@ -405,16 +462,29 @@ func resourceAwsSecurityGroupUpdate(d *schema.ResourceData, meta interface{}) er
 
 	group := sgRaw.(*ec2.SecurityGroup)
 
-	err = resourceAwsSecurityGroupUpdateRules(d, "ingress", meta, group)
-	if err != nil {
-		return err
-	}
+	if isSecurityGroupRuleManagementExternal(d) {
+		log.Printf("[DEBUG] Rules for Security Group (%s) are managed externally, skipping", d.Id())
+	} else {
+		// Rules added by aws_security_group_rule resources while the group was
+		// managed externally would otherwise be revoked by the next apply and
+		// then added again, with both sides fighting over them.
+		if d.HasChange("rule_management") && !d.IsNewResource() {
+			if err := validateSecurityGroupRulesDeclaredInline(d, group); err != nil {
+				return err
+			}
+		}
 
-	if d.Get("vpc_id") != nil {
-		err = resourceAwsSecurityGroupUpdateRules(d, "egress", meta, group)
+		err = resourceAwsSecurityGroupUpdateRules(d, "ingress", meta, group)
 		if err != nil {
 			return err
 		}
+
+		if d.Get("vpc_id") != nil {
+			err = resourceAwsSecurityGroupUpdateRules(d, "egress", meta, group)
+			if err != nil {
+				return err
+			}
+		}
 	}
 
 	if !d.IsNewResource() {
@@ -724,15 +794,34 @@ func resourceAwsSecurityGroupUpdateRules(
 		os := o.(*schema.Set)
 		ns := n.(*schema.Set)
 
-		remove, err := expandIPPerms(group, os.Difference(ns).List())
+		if err := validateSecurityGroupRulesUnique(ruleset, ns.List()); err != nil {
+			return err
+		}
+
+		// Rules which only differ in their description are updated in place
+		// rather than being revoked and authorized again.
+		removeRules, addRules, describeRules := splitSecurityGroupRuleDescriptionChanges(
+			os.Difference(ns).List(), ns.Difference(os).List())
+
+		remove, err := expandIPPerms(group, removeRules)
+		if err != nil {
+			return err
+		}
+		add, err := expandIPPerms(group, addRules)
 		if err != nil {
 			return err
 		}
-		add, err := expandIPPerms(group, ns.Difference(os).List())
+		describe, err := expandIPPerms(group, describeRules)
 		if err != nil {
 			return err
 		}
 
+		if len(describe) > 0 {
+			if err := updateSecurityGroupRuleDescriptions(meta.(*AWSClient).ec2conn, group, ruleset, describe); err != nil {
+				return err
+			}
+		}
+
 		// TODO: We need to handle partial state better in the in-between
 		// in this update.
 
@@ -809,6 +898,183 @@ func resourceAwsSecurityGroupUpdateRules(
 	return nil
 }
 
+func updateSecurityGroupRuleDescriptions(conn *ec2.EC2, group *ec2.SecurityGroup, ruleset string, perms []*ec2.IpPermission) error {
+	log.Printf("[DEBUG] Updating security group %s %s rule descriptions: %s",
+		aws.StringValue(group.GroupId), ruleset, perms)
+
+	var err error
+	if ruleset == "egress" {
+		_, err = conn.UpdateSecurityGroupRuleDescriptionsEgress(&ec2.UpdateSecurityGroupRuleDescriptionsEgressInput{
+			GroupId:       group.GroupId,
+			IpPermissions: perms,
+		})
+	} else {
+		req := &ec2.UpdateSecurityGroupRuleDescriptionsIngressInput{
+			GroupId:       group.GroupId,
+			IpPermissions: perms,
+		}
+		if group.VpcId == nil || *group.VpcId == "" {
+			req.GroupId = nil
+			req.GroupName = group.GroupName
+		}
+		_, err = conn.UpdateSecurityGroupRuleDescriptionsIngress(req)
+	}
+
+	if err != nil {
+		return fmt.Errorf(
+			"Error updating security group %s rule descriptions: %s",
+			ruleset, err)
+	}
+
+	return nil
+}
+
+// splitSecurityGroupRuleDescriptionChanges finds removed and added rules
+// which are identical apart from their description. Those are returned
+// separately so that they can be updated in place.
+func splitSecurityGroupRuleDescriptionChanges(remove, add []interface{}) ([]interface{}, []interface{}, []interface{}) {
+	withoutDescription := func(raw interface{}) int {
+		m := make(map[string]interface{})
+		for k, v := range raw.(map[string]interface{}) {
+			m[k] = v
+		}
+		m["description"] = ""
+		return resourceAwsSecurityGroupRuleHash(m)
+	}
+
+	removed := make(map[int]int)
+	for i, r := range remove {
+		removed[withoutDescription(r)] = i
+	}
+
+	matched := make(map[int]bool)
+	var newAdd, describe []interface{}
+	for _, a := range add {
+		if i, ok := removed[withoutDescription(a)]; ok && !matched[i] {
+			matched[i] = true
+			describe = append(describe, a)
+			continue
+		}
+		newAdd = append(newAdd, a)
+	}
+
+	var newRemove []interface{}
+	for i, r := range remove {
+		if !matched[i] {
+			newRemove = append(newRemove, r)
+		}
+	}
+
+	return newRemove, newAdd, describe
+}
+
+// validateSecurityGroupRulesUnique returns an error if the same permission
+// (protocol, port range and source) is declared by more than one rule, which
+// AWS would otherwise reject as a duplicate part way through an update.
+func validateSecurityGroupRulesUnique(ruleset string, rules []interface{}) error {
+	seen := make(map[string]bool)
+	for _, raw := range rules {
+		m := raw.(map[string]interface{})
+
+		var sources []string
+		for _, k := range []string{"cidr_blocks", "ipv6_cidr_blocks", "prefix_list_ids"} {
+			if v, ok := m[k]; ok {
+				for _, source := range v.([]interface{}) {
+					sources = append(sources, source.(string))
+				}
+			}
+		}
+		if v, ok := m["security_groups"]; ok {
+			for _, source := range v.(*schema.Set).List() {
+				sources = append(sources, source.(string))
+			}
+		}
+		if v, ok := m["self"]; ok && v.(bool) {
+			sources = append(sources, "self")
+		}
+
+		for _, source := range sources {
+			key := fmt.Sprintf("%s %d-%d %s", protocolForValue(m["protocol"].(string)),
+				m["from_port"].(int), m["to_port"].(int), source)
+			if seen[key] {
+				return fmt.Errorf("%s permission %q is declared by more than one %s rule", ruleset, key, ruleset)
+			}
+			seen[key] = true
+		}
+	}
+
+	return nil
+}
+
+// validateSecurityGroupRulesDeclaredInline returns an error if the group has a
+// permission which isn't declared by its inline ingress or egress rules, most
+// likely because it's managed by an aws_security_group_rule.
+func validateSecurityGroupRulesDeclaredInline(d *schema.ResourceData, group *ec2.SecurityGroup) error {
+	remote := map[string][]*ec2.IpPermission{
+		"ingress": group.IpPermissions,
+		"egress":  group.IpPermissionsEgress,
+	}
+	for _, ruleset := range []string{"ingress", "egress"} {
+		declared, err := expandIPPerms(group, d.Get(ruleset).(*schema.Set).List())
+		if err != nil {
+			return err
+		}
+
+		keys := make(map[string]bool)
+		for _, perm := range declared {
+			for _, key := range ipPermissionSourceKeys(group, perm) {
+				keys[key] = true
+			}
+		}
+
+		for _, perm := range remote[ruleset] {
+			for _, key := range ipPermissionSourceKeys(group, perm) {
+				if !keys[key] {
+					return fmt.Errorf("%s permission %q of Security Group (%s) is not declared inline. "+
+						"The same permission must not be managed by both aws_security_group_rule and inline rules; "+
+						"declare it inline and remove its aws_security_group_rule, or keep rule_management = %q.",
+						ruleset, key, aws.StringValue(group.GroupId), securityGroupRuleManagementExternal)
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// ipPermissionSourceKeys returns one "<protocol> <from>-<to> <source>" key per
+// source of the permission, so that permissions can be compared regardless of
+// how AWS or the configuration groups their sources.
+func ipPermissionSourceKeys(group *ec2.SecurityGroup, perm *ec2.IpPermission) []string {
+	var sources []string
+	for _, r := range perm.IpRanges {
+		sources = append(sources, aws.StringValue(r.CidrIp))
+	}
+	for _, r := range perm.Ipv6Ranges {
+		sources = append(sources, aws.StringValue(r.CidrIpv6))
+	}
+	for _, p := range perm.PrefixListIds {
+		sources = append(sources, aws.StringValue(p.PrefixListId))
+	}
+	for _, pair := range perm.UserIdGroupPairs {
+		source := aws.StringValue(pair.GroupId)
+		if source == "" {
+			source = aws.StringValue(pair.GroupName)
+		}
+		if source == aws.StringValue(group.GroupId) || source == aws.StringValue(group.GroupName) {
+			source = "self"
+		}
+		sources = append(sources, source)
+	}
+
+	keys := make([]string, 0, len(sources))
+	for _, source := range sources {
+		keys = append(keys, fmt.Sprintf("%s %d-%d %s", protocolForValue(aws.StringValue(perm.IpProtocol)),
+			aws.Int64Value(perm.FromPort), aws.Int64Value(perm.ToPort), source))
+	}
+	return keys
+}
+
 // SGStateRefreshFunc returns a resource.StateRefreshFunc that is used to watch
 // a security group.
 func SGStateRefreshFunc(conn *ec2.EC2, id string) resource.StateRefreshFunc {
@@ -140,6 +140,21 @@ func resourceAwsSecurityGroupRuleCreate(d *schema.ResourceData, meta interface{}
 	ruleType := d.Get("type").(string)
 	isVPC := sg.VpcId != nil && *sg.VpcId != ""
 
+	// Catch a permission that is already present before AWS rejects it, most
+	// commonly because it's also declared inline on the aws_security_group.
+	var existing []*ec2.IpPermission
+	if ruleType == "ingress" {
+		existing = sg.IpPermissions
+	} else {
+		existing = sg.IpPermissionsEgress
+	}
+	if rule := findRuleMatch(perm, existing, isVPC); rule != nil {
+		return fmt.Errorf("%s permission %s already exists on Security Group (%s). "+
+			"The same permission must not be managed by both aws_security_group_rule and inline rules; "+
+			"set rule_management = %q on the aws_security_group to manage its rules with aws_security_group_rule only.",
+			ruleType, perm, sg_id, securityGroupRuleManagementExternal)
+	}
+
 	var autherr error
 	switch ruleType {
 	case "ingress":
@@ -819,6 +834,10 @@ func resourceSecurityGroupRuleDescriptionUpdate(conn *ec2.EC2, d *schema.Resourc
 			GroupId:       sg.GroupId,
 			IpPermissions: []*ec2.IpPermission{perm},
 		}
+		if sg.VpcId == nil || *sg.VpcId == "" {
+			req.GroupId = nil
+			req.GroupName = sg.GroupName
+		}
 
 		_, err = conn.UpdateSecurityGroupRuleDescriptionsIngress(req)
 
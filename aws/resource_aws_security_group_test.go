@@ -337,6 +337,138 @@ func TestResourceAwsSecurityGroupIPPermGather(t *testing.T) {
 	}
 }
 
+func TestSplitSecurityGroupRuleDescriptionChanges(t *testing.T) {
+	rule := func(cidr, description string) map[string]interface{} {
+		return map[string]interface{}{
+			"protocol":        "tcp",
+			"from_port":       80,
+			"to_port":         80,
+			"self":            false,
+			"cidr_blocks":     []interface{}{cidr},
+			"security_groups": schema.NewSet(schema.HashString, nil),
+			"description":     description,
+		}
+	}
+
+	oldDescription := rule("10.0.0.0/8", "old")
+	newDescription := rule("10.0.0.0/8", "new")
+	removed := rule("10.1.0.0/16", "")
+	added := rule("10.2.0.0/16", "")
+
+	remove, add, describe := splitSecurityGroupRuleDescriptionChanges(
+		[]interface{}{oldDescription, removed}, []interface{}{newDescription, added})
+
+	if !reflect.DeepEqual(remove, []interface{}{removed}) {
+		t.Fatalf("bad remove: %#v", remove)
+	}
+	if !reflect.DeepEqual(add, []interface{}{added}) {
+		t.Fatalf("bad add: %#v", add)
+	}
+	if !reflect.DeepEqual(describe, []interface{}{newDescription}) {
+		t.Fatalf("bad describe: %#v", describe)
+	}
+}
+
+func TestValidateSecurityGroupRulesUnique(t *testing.T) {
+	rule := func(protocol string, cidrs ...interface{}) map[string]interface{} {
+		return map[string]interface{}{
+			"protocol":        protocol,
+			"from_port":       22,
+			"to_port":         22,
+			"self":            false,
+			"cidr_blocks":     cidrs,
+			"security_groups": schema.NewSet(schema.HashString, nil),
+			"description":     "",
+		}
+	}
+
+	unique := []interface{}{rule("tcp", "10.0.0.0/8"), rule("udp", "10.0.0.0/8", "192.168.0.0/16")}
+	if err := validateSecurityGroupRulesUnique("ingress", unique); err != nil {
+		t.Fatalf("expected no error, got: %s", err)
+	}
+
+	duplicate := []interface{}{rule("tcp", "10.0.0.0/8"), rule("6", "192.168.0.0/16", "10.0.0.0/8")}
+	if err := validateSecurityGroupRulesUnique("ingress", duplicate); err == nil {
+		t.Fatal("expected duplicate permission error")
+	}
+}
+
+func TestValidateSecurityGroupRulesDeclaredInline(t *testing.T) {
+	group := &ec2.SecurityGroup{
+		GroupId:   aws.String("sg-12345678"),
+		GroupName: aws.String("example"),
+		VpcId:     aws.String("vpc-12345678"),
+		IpPermissions: []*ec2.IpPermission{
+			{
+				IpProtocol: aws.String("tcp"),
+				FromPort:   aws.Int64(22),
+				ToPort:     aws.Int64(22),
+				IpRanges: []*ec2.IpRange{
+					{CidrIp: aws.String("10.0.0.0/8")},
+					{CidrIp: aws.String("192.168.0.0/16")},
+				},
+				UserIdGroupPairs: []*ec2.UserIdGroupPair{
+					{GroupId: aws.String("sg-12345678")},
+				},
+			},
+		},
+		IpPermissionsEgress: []*ec2.IpPermission{
+			{
+				IpProtocol: aws.String("-1"),
+				IpRanges: []*ec2.IpRange{
+					{CidrIp: aws.String("0.0.0.0/0")},
+				},
+			},
+		},
+	}
+
+	ingress := func(cidrs ...interface{}) map[string]interface{} {
+		return map[string]interface{}{
+			"protocol":    "6",
+			"from_port":   22,
+			"to_port":     22,
+			"cidr_blocks": cidrs,
+		}
+	}
+	self := map[string]interface{}{
+		"protocol":  "tcp",
+		"from_port": 22,
+		"to_port":   22,
+		"self":      true,
+	}
+	egress := map[string]interface{}{
+		"protocol":    "-1",
+		"from_port":   0,
+		"to_port":     0,
+		"cidr_blocks": []interface{}{"0.0.0.0/0"},
+	}
+
+	// AWS merges the sources of permissions with the same ports, while the
+	// configuration may declare them as separate rules.
+	d := schema.TestResourceDataRaw(t, resourceAwsSecurityGroup().Schema, map[string]interface{}{
+		"ingress": []interface{}{ingress("10.0.0.0/8"), ingress("192.168.0.0/16"), self},
+		"egress":  []interface{}{egress},
+	})
+	if err := validateSecurityGroupRulesDeclaredInline(d, group); err != nil {
+		t.Fatalf("expected no error, got: %s", err)
+	}
+
+	d = schema.TestResourceDataRaw(t, resourceAwsSecurityGroup().Schema, map[string]interface{}{
+		"ingress": []interface{}{ingress("10.0.0.0/8"), self},
+		"egress":  []interface{}{egress},
+	})
+	if err := validateSecurityGroupRulesDeclaredInline(d, group); err == nil {
+		t.Fatal("expected an error for a permission which isn't declared inline")
+	}
+
+	d = schema.TestResourceDataRaw(t, resourceAwsSecurityGroup().Schema, map[string]interface{}{
+		"ingress": []interface{}{ingress("10.0.0.0/8", "192.168.0.0/16"), self},
+	})
+	if err := validateSecurityGroupRulesDeclaredInline(d, group); err == nil {
+		t.Fatal("expected an error for an egress permission which isn't declared inline")
+	}
+}
+
 func TestAccAWSSecurityGroup_basic(t *testing.T) {
 	var group ec2.SecurityGroup
 
@@ -1000,6 +1132,40 @@ func TestAccAWSSecurityGroup_ChangeRuleDescription(t *testing.T) {
 	})
 }
 
+func TestAccAWSSecurityGroup_ruleManagementExternal(t *testing.T) {
+	var group ec2.SecurityGroup
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSecurityGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSecurityGroupConfigRuleManagementExternal,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSecurityGroupExists("aws_security_group.web", &group),
+					resource.TestCheckResourceAttr(
+						"aws_security_group.web", "rule_management", "external"),
+				),
+			},
+			// A refresh must not plan to revoke the externally managed rule.
+			{
+				Config:   testAccAWSSecurityGroupConfigRuleManagementExternal,
+				PlanOnly: true,
+			},
+			{
+				Config:      testAccAWSSecurityGroupConfigRuleManagementExternalInline,
+				ExpectError: regexp.MustCompile(`cannot be declared inline when rule_management is "external"`),
+			},
+			// Switching to inline must not silently take over the rule.
+			{
+				Config:      testAccAWSSecurityGroupConfigRuleManagementInlineWithRule,
+				ExpectError: regexp.MustCompile(`is not declared inline`),
+			},
+		},
+	})
+}
+
 func TestAccAWSSecurityGroup_generatedName(t *testing.T) {
 	var group ec2.SecurityGroup
 
@@ -1728,6 +1894,91 @@ resource "aws_security_group" "web" {
 }
 `
 
+const testAccAWSSecurityGroupConfigRuleManagementExternal = `
+resource "aws_vpc" "foo" {
+  cidr_block = "10.1.0.0/16"
+	tags {
+		Name = "terraform-testacc-security-group-rule-management"
+	}
+}
+
+resource "aws_security_group" "web" {
+  name = "terraform_acceptance_test_rule_management"
+  description = "Used in the terraform acceptance tests"
+  vpc_id = "${aws_vpc.foo.id}"
+  rule_management = "external"
+}
+
+resource "aws_security_group_rule" "ingress" {
+  type = "ingress"
+  protocol = "tcp"
+  from_port = 80
+  to_port = 8000
+  cidr_blocks = ["10.0.0.0/8"]
+  description = "Ingress description"
+  security_group_id = "${aws_security_group.web.id}"
+}
+`
+
+const testAccAWSSecurityGroupConfigRuleManagementInlineWithRule = `
+resource "aws_vpc" "foo" {
+  cidr_block = "10.1.0.0/16"
+	tags {
+		Name = "terraform-testacc-security-group-rule-management"
+	}
+}
+
+resource "aws_security_group" "web" {
+  name = "terraform_acceptance_test_rule_management"
+  description = "Used in the terraform acceptance tests"
+  vpc_id = "${aws_vpc.foo.id}"
+  rule_management = "inline"
+}
+
+resource "aws_security_group_rule" "ingress" {
+  type = "ingress"
+  protocol = "tcp"
+  from_port = 80
+  to_port = 8000
+  cidr_blocks = ["10.0.0.0/8"]
+  description = "Ingress description"
+  security_group_id = "${aws_security_group.web.id}"
+}
+`
+
+const testAccAWSSecurityGroupConfigRuleManagementExternalInline = `
+resource "aws_vpc" "foo" {
+  cidr_block = "10.1.0.0/16"
+	tags {
+		Name = "terraform-testacc-security-group-rule-management"
+	}
+}
+
+resource "aws_security_group" "web" {
+  name = "terraform_acceptance_test_rule_management"
+  description = "Used in the terraform acceptance tests"
+  vpc_id = "${aws_vpc.foo.id}"
+  rule_management = "external"
+
+  ingress {
+    protocol = "tcp"
+    from_port = 22
+    to_port = 22
+    cidr_blocks = ["10.0.0.0/8"]
+  }
+}
+
+resource "aws_security_group_rule" "ingress" {
+  type = "ingress"
+  protocol = "tcp"
+  from_port = 80
+  to_port = 8000
+  cidr_blocks = ["10.0.0.0/8"]
+  description = "Ingress description"
+  security_group_id = "${aws_security_group.web.id}"
+}
+`
+
 const testAccAWSSecurityGroupConfig = `
 resource "aws_vpc" "foo" {
   cidr_block = "10.1.0.0/16"
@@ -36,6 +36,10 @@ func resourceAwsSpotInstanceRequest() *schema.Resource {
 				v.ForceNew = true
 			}
 
+			// Spot instances cannot be launched onto Dedicated Hosts.
+			delete(s, "host_id")
+			delete(s, "affinity")
+
 			s["volume_tags"] = &schema.Schema{
 				Type:     schema.TypeMap,
 				Optional: true,
@@ -1,6 +1,7 @@
 package aws
 
 import (
+	"fmt"
 	"log"
 	"regexp"
 
@@ -52,6 +53,43 @@ func setTagsS3(conn *s3.S3, d *schema.ResourceData) error {
 	return nil
 }
 
+// setObjectTagsS3 is a helper to set the tags for an S3 object. It expects
+// the tags field to be named "tags". Unlike bucket tags, the object tag-set
+// is always replaced as a whole.
+func setObjectTagsS3(conn *s3.S3, d *schema.ResourceData) error {
+	if d.HasChange("tags") {
+		bucket := d.Get("bucket").(string)
+		key := d.Get("key").(string)
+		tags := tagsFromMapS3(d.Get("tags").(map[string]interface{}))
+
+		if len(tags) == 0 {
+			log.Printf("[DEBUG] Removing tags from S3 object %s/%s", bucket, key)
+			_, err := conn.DeleteObjectTagging(&s3.DeleteObjectTaggingInput{
+				Bucket: aws.String(bucket),
+				Key:    aws.String(key),
+			})
+			if err != nil {
+				return fmt.Errorf("Error removing tags from S3 object (bucket: %s, key: %s): %s", bucket, key, err)
+			}
+			return nil
+		}
+
+		log.Printf("[DEBUG] Setting tags on S3 object %s/%s: %#v", bucket, key, tags)
+		_, err := conn.PutObjectTagging(&s3.PutObjectTaggingInput{
+			Bucket: aws.String(bucket),
+			Key:    aws.String(key),
+			Tagging: &s3.Tagging{
+				TagSet: tags,
+			},
+		})
+		if err != nil {
+			return fmt.Errorf("Error setting tags on S3 object (bucket: %s, key: %s): %s", bucket, key, err)
+		}
+	}
+
+	return nil
+}
+
 // diffTags takes our tags locally and the ones remotely and returns
 // the set of tags that must be created, and the set of tags that must
 // be destroyed.
@@ -0,0 +1,185 @@
+package aws
+
+import (
+	"bytes"
+	"crypto/md5"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+)
+
+const (
+	// s3MultipartMinPartSize is the smallest part S3 accepts for all but
+	// the last part of a multipart upload.
+	s3MultipartMinPartSize = 5 * 1024 * 1024
+
+	// s3MultipartMaxParts is the largest number of parts S3 accepts for a
+	// single multipart upload.
+	s3MultipartMaxParts = 10000
+
+	// s3MultipartDefaultPartSize is used both as the part size and as the
+	// threshold above which objects are uploaded in parts, unless configured
+	// otherwise.
+	s3MultipartDefaultPartSize = 16 * 1024 * 1024
+)
+
+// s3UploadBody is what putS3Object needs to read an object: it is seeked for
+// a single PutObject and read at offsets for the parts of a multipart upload.
+// Both *os.File and *bytes.Reader satisfy it.
+type s3UploadBody interface {
+	io.ReadSeeker
+	io.ReaderAt
+}
+
+// putS3Object uploads size bytes of body using the headers in input. Objects
+// larger than threshold are sent as a multipart upload with parts of
+// partSize bytes, so that the resulting ETag is the one s3ObjectEtag computes
+// for the same threshold and part size.
+func putS3Object(conn *s3.S3, input *s3.PutObjectInput, body s3UploadBody, size, threshold, partSize int64) (*s3.PutObjectOutput, error) {
+	if size <= threshold {
+		input.Body = body
+		return conn.PutObject(input)
+	}
+
+	if err := validateS3MultipartPartSize(size, partSize); err != nil {
+		return nil, err
+	}
+
+	bucket := input.Bucket
+	key := input.Key
+
+	created, err := conn.CreateMultipartUpload(&s3.CreateMultipartUploadInput{
+		ACL:                     input.ACL,
+		Bucket:                  bucket,
+		CacheControl:            input.CacheControl,
+		ContentDisposition:      input.ContentDisposition,
+		ContentEncoding:         input.ContentEncoding,
+		ContentLanguage:         input.ContentLanguage,
+		ContentType:             input.ContentType,
+		Key:                     key,
+		Metadata:                input.Metadata,
+		SSEKMSKeyId:             input.SSEKMSKeyId,
+		ServerSideEncryption:    input.ServerSideEncryption,
+		StorageClass:            input.StorageClass,
+		Tagging:                 input.Tagging,
+		WebsiteRedirectLocation: input.WebsiteRedirectLocation,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Error creating multipart upload: %s", err)
+	}
+	uploadId := created.UploadId
+
+	var parts []*s3.CompletedPart
+	for offset, number := int64(0), int64(1); offset < size; offset, number = offset+partSize, number+1 {
+		length := partSize
+		if remaining := size - offset; remaining < length {
+			length = remaining
+		}
+
+		log.Printf("[DEBUG] Uploading part %d of s3://%s/%s (%d bytes)", number, aws.StringValue(bucket), aws.StringValue(key), length)
+		out, err := conn.UploadPart(&s3.UploadPartInput{
+			Body:          io.NewSectionReader(body, offset, length),
+			Bucket:        bucket,
+			ContentLength: aws.Int64(length),
+			Key:           key,
+			PartNumber:    aws.Int64(number),
+			UploadId:      uploadId,
+		})
+		if err != nil {
+			abortS3MultipartUpload(conn, bucket, key, uploadId)
+			return nil, fmt.Errorf("Error uploading part %d: %s", number, err)
+		}
+
+		parts = append(parts, &s3.CompletedPart{
+			ETag:       out.ETag,
+			PartNumber: aws.Int64(number),
+		})
+	}
+
+	completed, err := conn.CompleteMultipartUpload(&s3.CompleteMultipartUploadInput{
+		Bucket: bucket,
+		Key:    key,
+		MultipartUpload: &s3.CompletedMultipartUpload{
+			Parts: parts,
+		},
+		UploadId: uploadId,
+	})
+	if err != nil {
+		abortS3MultipartUpload(conn, bucket, key, uploadId)
+		return nil, fmt.Errorf("Error completing multipart upload: %s", err)
+	}
+
+	return &s3.PutObjectOutput{
+		ETag:                 completed.ETag,
+		Expiration:           completed.Expiration,
+		SSEKMSKeyId:          completed.SSEKMSKeyId,
+		ServerSideEncryption: completed.ServerSideEncryption,
+		VersionId:            completed.VersionId,
+	}, nil
+}
+
+func abortS3MultipartUpload(conn *s3.S3, bucket, key, uploadId *string) {
+	_, err := conn.AbortMultipartUpload(&s3.AbortMultipartUploadInput{
+		Bucket:   bucket,
+		Key:      key,
+		UploadId: uploadId,
+	})
+	if err != nil {
+		log.Printf("[WARN] Error aborting multipart upload %s of s3://%s/%s: %s",
+			aws.StringValue(uploadId), aws.StringValue(bucket), aws.StringValue(key), err)
+	}
+}
+
+func validateS3MultipartPartSize(size, partSize int64) error {
+	if partSize < s3MultipartMinPartSize {
+		return fmt.Errorf("multipart upload part size must be at least %d bytes, got %d", s3MultipartMinPartSize, partSize)
+	}
+	if parts := (size + partSize - 1) / partSize; parts > s3MultipartMaxParts {
+		return fmt.Errorf("an object of %d bytes needs %d parts of %d bytes, more than the %d S3 allows; increase the part size",
+			size, parts, partSize, s3MultipartMaxParts)
+	}
+	return nil
+}
+
+// s3ObjectEtag returns the ETag S3 reports for an unencrypted (or SSE-S3
+// encrypted) object uploaded by putS3Object with the same threshold and part
+// size: the MD5 of the content for a single PutObject, or the MD5 of the
+// concatenated part MD5s followed by "-<number of parts>" for a multipart
+// upload.
+func s3ObjectEtag(r io.Reader, size, threshold, partSize int64) (string, error) {
+	if size <= threshold {
+		h := md5.New()
+		if _, err := io.Copy(h, r); err != nil {
+			return "", err
+		}
+		return hex.EncodeToString(h.Sum(nil)), nil
+	}
+
+	if err := validateS3MultipartPartSize(size, partSize); err != nil {
+		return "", err
+	}
+
+	var digests bytes.Buffer
+	parts := 0
+	for {
+		h := md5.New()
+		n, err := io.CopyN(h, r, partSize)
+		if n > 0 {
+			digests.Write(h.Sum(nil))
+			parts++
+		}
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return "", err
+		}
+	}
+
+	sum := md5.Sum(digests.Bytes())
+	return fmt.Sprintf("%s-%d", hex.EncodeToString(sum[:]), parts), nil
+}
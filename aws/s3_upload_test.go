@@ -0,0 +1,97 @@
+package aws
+
+import (
+	"bytes"
+	"crypto/md5"
+	"encoding/hex"
+	"fmt"
+	"testing"
+)
+
+func TestS3ObjectEtag(t *testing.T) {
+	content := bytes.Repeat([]byte("0123456789"), (s3MultipartMinPartSize*2+1024)/10)
+	size := int64(len(content))
+
+	single := md5.Sum(content)
+
+	part1 := md5.Sum(content[:s3MultipartMinPartSize])
+	part2 := md5.Sum(content[s3MultipartMinPartSize : 2*s3MultipartMinPartSize])
+	part3 := md5.Sum(content[2*s3MultipartMinPartSize:])
+	parts := append(append(part1[:], part2[:]...), part3[:]...)
+	multipart := md5.Sum(parts)
+
+	testCases := []struct {
+		Threshold int64
+		PartSize  int64
+		Expected  string
+	}{
+		{
+			Threshold: size,
+			PartSize:  s3MultipartMinPartSize,
+			Expected:  hex.EncodeToString(single[:]),
+		},
+		{
+			Threshold: s3MultipartMinPartSize,
+			PartSize:  s3MultipartMinPartSize,
+			Expected:  fmt.Sprintf("%s-3", hex.EncodeToString(multipart[:])),
+		},
+	}
+
+	for i, tc := range testCases {
+		etag, err := s3ObjectEtag(bytes.NewReader(content), size, tc.Threshold, tc.PartSize)
+		if err != nil {
+			t.Fatalf("Case #%d: unexpected error: %s", i, err)
+		}
+		if etag != tc.Expected {
+			t.Errorf("Case #%d: expected %q, got %q", i, tc.Expected, etag)
+		}
+	}
+}
+
+func TestS3ObjectEtag_exactParts(t *testing.T) {
+	content := bytes.Repeat([]byte{'a'}, 2*s3MultipartMinPartSize)
+
+	etag, err := s3ObjectEtag(bytes.NewReader(content), int64(len(content)), 0, s3MultipartMinPartSize)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	part := md5.Sum(content[:s3MultipartMinPartSize])
+	sum := md5.Sum(append(part[:], part[:]...))
+	if expected := fmt.Sprintf("%s-2", hex.EncodeToString(sum[:])); etag != expected {
+		t.Fatalf("expected %q, got %q", expected, etag)
+	}
+}
+
+func TestValidateS3MultipartPartSize(t *testing.T) {
+	testCases := []struct {
+		Size     int64
+		PartSize int64
+		ErrCount int
+	}{
+		{
+			Size:     100 * 1024 * 1024,
+			PartSize: s3MultipartMinPartSize,
+		},
+		{
+			Size:     100 * 1024 * 1024,
+			PartSize: 1024,
+			ErrCount: 1,
+		},
+		{
+			Size:     s3MultipartMaxParts*s3MultipartMinPartSize + 1,
+			PartSize: s3MultipartMinPartSize,
+			ErrCount: 1,
+		},
+	}
+
+	for i, tc := range testCases {
+		err := validateS3MultipartPartSize(tc.Size, tc.PartSize)
+		if tc.ErrCount == 0 && err != nil {
+			t.Errorf("Case #%d: unexpected error: %s", i, err)
+		}
+		if tc.ErrCount > 0 && err == nil {
+			t.Errorf("Case #%d: expected an error", i)
+		}
+	}
+}
@@ -3436,6 +3436,36 @@ func flattenDynamoDbTtl(ttlDesc *dynamodb.TimeToLiveDescription) []interface{} {
 	return []interface{}{}
 }
 
+func expandDynamoDbTableServerSideEncryption(l []interface{}) *dynamodb.SSESpecification {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	return &dynamodb.SSESpecification{
+		Enabled: aws.Bool(m["enabled"].(bool)),
+	}
+}
+
+// Tables created without encryption have no SSEDescription at all, so they're
+// flattened as disabled to keep an explicit `enabled = false` from showing a diff.
+func flattenDynamoDbTableServerSideEncryption(description *dynamodb.SSEDescription) []interface{} {
+	enabled := false
+	if description != nil {
+		switch aws.StringValue(description.Status) {
+		case dynamodb.SSEStatusEnabled, dynamodb.SSEStatusEnabling:
+			enabled = true
+		}
+	}
+
+	m := map[string]interface{}{
+		"enabled": enabled,
+	}
+
+	return []interface{}{m}
+}
+
 func flattenAwsDynamoDbTableResource(d *schema.ResourceData, table *dynamodb.TableDescription) error {
 	d.Set("write_capacity", table.ProvisionedThroughput.WriteCapacityUnits)
 	d.Set("read_capacity", table.ProvisionedThroughput.ReadCapacityUnits)
@@ -3529,6 +3559,10 @@ func flattenAwsDynamoDbTableResource(d *schema.ResourceData, table *dynamodb.Tab
 	d.Set("stream_arn", table.LatestStreamArn)
 	d.Set("stream_label", table.LatestStreamLabel)
 
+	if err := d.Set("server_side_encryption", flattenDynamoDbTableServerSideEncryption(table.SSEDescription)); err != nil {
+		return err
+	}
+
 	err = d.Set("global_secondary_index", gsiList)
 	if err != nil {
 		return err
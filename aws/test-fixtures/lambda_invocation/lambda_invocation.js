@@ -0,0 +1,9 @@
+exports.handler = function(event, context, callback) {
+    callback(null, {
+        key1: event.key1,
+        key2: event.key2,
+        nested: {
+            key3: event.key3
+        }
+    });
+};
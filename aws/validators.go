@@ -954,6 +954,18 @@ func validateAwsDynamoDbGlobalTableName(v interface{}, k string) (ws []string, e
 	return
 }
 
+func validateAwsDynamoDbTableBackupName(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if (len(value) > 255) || (len(value) < 3) {
+		errors = append(errors, fmt.Errorf("%s length must be between 3 and 255 characters: %q", k, value))
+	}
+	pattern := `^[a-zA-Z0-9_.-]+$`
+	if !regexp.MustCompile(pattern).MatchString(value) {
+		errors = append(errors, fmt.Errorf("%s must only include alphanumeric, underscore, period, or hyphen characters: %q", k, value))
+	}
+	return
+}
+
 // Validates that an Ecs placement strategy is set correctly
 // Takes type, and field as strings
 func validateAwsEcsPlacementStrategy(stratType, stratField string) error {
@@ -2317,3 +2329,14 @@ func validateIotThingTypeSearchableAttribute(v interface{}, k string) (ws []stri
 	}
 	return
 }
+
+func validateEc2ReservedInstancesDuration(v interface{}, k string) (ws []string, errors []error) {
+	// Reservations are sold for one or three years, expressed in seconds.
+	switch v.(int) {
+	case 31536000, 94608000:
+	default:
+		errors = append(errors, fmt.Errorf(
+			"%q must be 31536000 (1 year) or 94608000 (3 years), got %d", k, v.(int)))
+	}
+	return
+}
@@ -3233,3 +3233,19 @@ func TestValidateAmazonSideAsn(t *testing.T) {
 		}
 	}
 }
+
+func TestValidateEc2ReservedInstancesDuration(t *testing.T) {
+	for _, v := range []int{31536000, 94608000} {
+		_, errors := validateEc2ReservedInstancesDuration(v, "duration")
+		if len(errors) != 0 {
+			t.Fatalf("%d should be a valid duration: %q", v, errors)
+		}
+	}
+
+	for _, v := range []int{0, 1, 63072000} {
+		_, errors := validateEc2ReservedInstancesDuration(v, "duration")
+		if len(errors) == 0 {
+			t.Fatalf("%d should be an invalid duration", v)
+		}
+	}
+}